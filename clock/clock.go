@@ -0,0 +1,245 @@
+// Package clock provides a pluggable time source so that time-dependent collections
+// can run against the system clock in production and a manually advanced clock in tests.
+// Collections that wait for a point in time take a TimerClock, whose timers a Fake clock
+// fires only when it is advanced.
+package clock
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock reports the current time.
+// Collections that expire or schedule elements take a Clock instead of calling time.Now directly.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// Timer delivers the current time on its channel once, when its duration has elapsed.
+type Timer interface {
+	// C returns the channel on which the time is delivered.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing. It returns false if the timer already fired or was stopped.
+	Stop() bool
+}
+
+// TimerClock is a Clock that can also create timers.
+// Collections that block until a deadline take a TimerClock so that waiting can be driven by a Fake.
+type TimerClock interface {
+	Clock
+	// NewTimer returns a Timer that fires after at least d has elapsed on this clock.
+	NewTimer(d time.Duration) Timer
+	// NewTimerAt returns a Timer that fires once this clock reaches at. Code that waits for a
+	// deadline should prefer it to NewTimer(at.Sub(Now())), which fires late if the clock moves
+	// between the two calls.
+	NewTimerAt(at time.Time) Timer
+}
+
+// systemClock is the TimerClock backed by time.Now and time.NewTimer.
+type systemClock struct{}
+
+// Now returns time.Now().
+func (systemClock) Now() time.Time { return time.Now() }
+
+// NewTimer returns a Timer backed by time.NewTimer.
+func (systemClock) NewTimer(d time.Duration) Timer { return systemTimer{time.NewTimer(d)} }
+
+// NewTimerAt returns a Timer backed by time.NewTimer that fires at the given time.
+func (systemClock) NewTimerAt(at time.Time) Timer { return systemTimer{time.NewTimer(time.Until(at))} }
+
+// systemTimer adapts a *time.Timer to the Timer interface.
+type systemTimer struct {
+	timer *time.Timer // the underlying runtime timer
+}
+
+// C returns the channel of the underlying timer.
+func (t systemTimer) C() <-chan time.Time { return t.timer.C }
+
+// Stop stops the underlying timer.
+func (t systemTimer) Stop() bool { return t.timer.Stop() }
+
+// System returns a TimerClock backed by time.Now and time.NewTimer.
+//
+// Returns:
+//   - the system clock
+//
+// Example:
+//
+//	m := ttlmap.NewWithClock[string, int](time.Minute, clock.System())
+func System() TimerClock {
+	return systemClock{}
+}
+
+// Fake is a TimerClock whose time only changes when Advance or Set is called,
+// making expiry deterministic in tests. Its timers fire as soon as the fake time
+// reaches their deadline. It is safe for concurrent use.
+type Fake struct {
+	mu      sync.Mutex    // guards every field below
+	now     time.Time     // the current fake time
+	timers  []*fakeTimer  // timers that have not fired or been stopped
+	changed chan struct{} // closed when a timer is created, nil while no BlockUntil is waiting
+}
+
+// fakeTimer is a Timer created by a Fake clock.
+type fakeTimer struct {
+	fake *Fake          // the clock that fires the timer
+	at   time.Time      // the fake time at which the timer fires
+	c    chan time.Time // buffered so that firing never blocks
+}
+
+// NewFake creates and returns a new Fake clock starting at the given time.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - now: the initial time of the clock
+//
+// Returns:
+//   - a new Fake clock
+//
+// Example:
+//
+//	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+//	fake.Advance(time.Hour)
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the current fake time.
+// Time complexity: O(1).
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// NewTimer returns a Timer that fires once the fake time reaches Now() + d.
+// A d of zero or less fires immediately.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - d: the fake duration to wait
+//
+// Returns:
+//   - a new pending Timer
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.addTimer(f.now.Add(d))
+}
+
+// NewTimerAt returns a Timer that fires once the fake time reaches at.
+// A time that has already been reached fires immediately.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - at: the fake time at which the timer fires
+//
+// Returns:
+//   - a new pending Timer
+func (f *Fake) NewTimerAt(at time.Time) Timer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.addTimer(at)
+}
+
+// BlockUntil waits until at least n timers are pending on the clock.
+// Tests call it before Advance to make sure the code under test has started waiting.
+// Time complexity: O(1) per timer created while waiting.
+//
+// Parameters:
+//   - n: the number of pending timers to wait for
+//
+// Example:
+//
+//	go worker(fake)
+//	fake.BlockUntil(1)  // worker is now waiting on a timer
+//	fake.Advance(time.Minute)
+func (f *Fake) BlockUntil(n int) {
+	for {
+		f.mu.Lock()
+		if len(f.timers) >= n {
+			f.mu.Unlock()
+			return
+		}
+		if f.changed == nil {
+			f.changed = make(chan struct{})
+		}
+		wait := f.changed
+		f.mu.Unlock()
+		<-wait
+	}
+}
+
+// Advance moves the fake time forward by d and fires every timer whose deadline has been reached.
+// A negative d moves it backward.
+// Time complexity: O(t) where t is the number of pending timers.
+//
+// Parameters:
+//   - d: the duration to add to the current time
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+	f.fire()
+}
+
+// Set sets the fake time to t and fires every timer whose deadline has been reached.
+// Time complexity: O(t) where t is the number of pending timers.
+//
+// Parameters:
+//   - t: the new current time
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = t
+	f.fire()
+}
+
+// addTimer creates a timer that fires at the given time, firing it immediately if that time
+// has been reached. f.mu must be held.
+func (f *Fake) addTimer(at time.Time) *fakeTimer {
+	t := &fakeTimer{fake: f, at: at, c: make(chan time.Time, 1)}
+	if !at.After(f.now) {
+		t.c <- f.now
+		return t
+	}
+	f.timers = append(f.timers, t)
+	if f.changed != nil {
+		close(f.changed)
+		f.changed = nil
+	}
+	return t
+}
+
+// fire delivers the current time to every pending timer that is due and forgets them. f.mu must be held.
+func (f *Fake) fire() {
+	pending := f.timers[:0]
+	for _, t := range f.timers {
+		if t.at.After(f.now) {
+			pending = append(pending, t)
+		} else {
+			t.c <- f.now
+		}
+	}
+	clear(f.timers[len(pending):])
+	f.timers = pending
+}
+
+// C returns the channel on which the fake time is delivered.
+func (t *fakeTimer) C() <-chan time.Time { return t.c }
+
+// Stop removes the timer from its clock if it has not fired yet.
+func (t *fakeTimer) Stop() bool {
+	f := t.fake
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, pending := range f.timers {
+		if pending == t {
+			f.timers = slices.Delete(f.timers, i, i+1)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package clock
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSystem(t *testing.T) {
+	before := time.Now()
+	now := System().Now()
+	after := time.Now()
+	if now.Before(before) || now.After(after) {
+		t.Errorf("Expected system time between %v and %v, got %v", before, after, now)
+	}
+}
+
+func TestFake(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Now does not move on its own", func(t *testing.T) {
+		f := NewFake(start)
+		if !f.Now().Equal(start) {
+			t.Errorf("Expected %v, got %v", start, f.Now())
+		}
+		time.Sleep(time.Millisecond)
+		if !f.Now().Equal(start) {
+			t.Errorf("Expected %v, got %v", start, f.Now())
+		}
+	})
+
+	t.Run("Advance and Set", func(t *testing.T) {
+		f := NewFake(start)
+		f.Advance(90 * time.Minute)
+		if expected := start.Add(90 * time.Minute); !f.Now().Equal(expected) {
+			t.Errorf("Expected %v, got %v", expected, f.Now())
+		}
+		f.Advance(-30 * time.Minute)
+		if expected := start.Add(time.Hour); !f.Now().Equal(expected) {
+			t.Errorf("Expected %v, got %v", expected, f.Now())
+		}
+		f.Set(start)
+		if !f.Now().Equal(start) {
+			t.Errorf("Expected %v, got %v", start, f.Now())
+		}
+	})
+
+	t.Run("Concurrent use", func(t *testing.T) {
+		f := NewFake(start)
+		var wg sync.WaitGroup
+		for i := 0; i < 16; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					f.Advance(time.Second)
+					f.Now()
+				}
+			}()
+		}
+		wg.Wait()
+		if expected := start.Add(1600 * time.Second); !f.Now().Equal(expected) {
+			t.Errorf("Expected %v, got %v", expected, f.Now())
+		}
+	})
+}
+
+func TestSystemTimer(t *testing.T) {
+	timer := System().NewTimer(time.Millisecond)
+	select {
+	case <-timer.C():
+	case <-time.After(time.Second):
+		t.Fatal("Expected system timer to fire")
+	}
+	if timer.Stop() {
+		t.Error("Expected Stop of a fired timer to return false")
+	}
+
+	timer = System().NewTimer(time.Hour)
+	if !timer.Stop() {
+		t.Error("Expected Stop of a pending timer to return true")
+	}
+}
+
+func TestFakeTimer(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	fired := func(timer Timer) bool {
+		select {
+		case <-timer.C():
+			return true
+		default:
+			return false
+		}
+	}
+
+	t.Run("fires when time reaches deadline", func(t *testing.T) {
+		f := NewFake(start)
+		timer := f.NewTimer(time.Minute)
+		f.Advance(59 * time.Second)
+		if fired(timer) {
+			t.Fatal("Expected timer not to fire before its deadline")
+		}
+		f.Advance(time.Second)
+		select {
+		case now := <-timer.C():
+			if expected := start.Add(time.Minute); !now.Equal(expected) {
+				t.Errorf("Expected %v, got %v", expected, now)
+			}
+		default:
+			t.Fatal("Expected timer to fire at its deadline")
+		}
+		if timer.Stop() {
+			t.Error("Expected Stop of a fired timer to return false")
+		}
+	})
+
+	t.Run("Set fires only due timers", func(t *testing.T) {
+		f := NewFake(start)
+		soon := f.NewTimer(time.Second)
+		late := f.NewTimer(time.Hour)
+		f.Set(start.Add(time.Minute))
+		if !fired(soon) || fired(late) {
+			t.Error("Expected only the earlier timer to fire")
+		}
+		if !late.Stop() {
+			t.Error("Expected Stop of a pending timer to return true")
+		}
+		f.Advance(2 * time.Hour)
+		if fired(late) {
+			t.Error("Expected stopped timer not to fire")
+		}
+	})
+
+	t.Run("non-positive duration fires immediately", func(t *testing.T) {
+		f := NewFake(start)
+		if !fired(f.NewTimer(0)) || !fired(f.NewTimer(-time.Second)) {
+			t.Error("Expected timers with non-positive durations to fire immediately")
+		}
+	})
+
+	t.Run("BlockUntil waits for timers", func(t *testing.T) {
+		f := NewFake(start)
+		done := make(chan time.Time)
+		go func() {
+			done <- <-f.NewTimer(time.Minute).C()
+		}()
+		f.BlockUntil(1)
+		f.Advance(time.Minute)
+		select {
+		case now := <-done:
+			if expected := start.Add(time.Minute); !now.Equal(expected) {
+				t.Errorf("Expected %v, got %v", expected, now)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Expected waiting goroutine to be released")
+		}
+		f.BlockUntil(0)
+	})
+}
+
+func TestNewTimerAt(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("fake uses absolute deadline", func(t *testing.T) {
+		f := NewFake(start)
+		f.Advance(time.Minute)
+		timer := f.NewTimerAt(start.Add(2 * time.Minute))
+		f.Advance(time.Minute)
+		select {
+		case now := <-timer.C():
+			if expected := start.Add(2 * time.Minute); !now.Equal(expected) {
+				t.Errorf("Expected %v, got %v", expected, now)
+			}
+		default:
+			t.Fatal("Expected timer to fire at its absolute deadline")
+		}
+		select {
+		case <-f.NewTimerAt(start).C():
+		default:
+			t.Error("Expected timer for a past time to fire immediately")
+		}
+	})
+
+	t.Run("system clock", func(t *testing.T) {
+		timer := System().NewTimerAt(time.Now().Add(time.Millisecond))
+		select {
+		case <-timer.C():
+		case <-time.After(time.Second):
+			t.Fatal("Expected system timer to fire")
+		}
+	})
+}
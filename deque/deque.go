@@ -0,0 +1,485 @@
+// Package deque provides a generic double-ended queue implementation
+// backed by a growable ring buffer for efficient operations at both ends.
+package deque
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/thefrost13/gollections/errs"
+	"github.com/thefrost13/gollections/internal/ring"
+)
+
+// Deque is a generic double-ended queue implemented using a slice as a circular buffer.
+// It provides amortized O(1) push and pop at both ends and O(1) indexed access.
+// The buffer doubles when full and halves when it falls to a quarter full.
+// The zero value is ready to use but New should be preferred for initialization.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type Deque[T any] struct {
+	buf  []T // circular buffer; its length is zero or a power of two
+	head int // index of the front element in buf
+	size int // number of elements in the deque
+}
+
+// New creates and returns a new Deque initialized with the elements from the given slice.
+// The first element of the slice becomes the front of the deque.
+// If the slice is nil, an empty deque is returned.
+// Time complexity: O(n) where n is the length of the slice.
+//
+// Parameters:
+//   - sli: slice of elements to initialize the deque with, can be nil
+//
+// Returns:
+//   - a new Deque containing the elements from the slice
+//
+// Example:
+//
+//	d := New([]int{1, 2, 3})  // Creates deque with 1 at front and 3 at back
+//	emptyDeque := New[string](nil)  // Creates empty deque
+func New[T any](sli []T) *Deque[T] {
+	d := &Deque[T]{}
+	if len(sli) > 0 {
+		d.resize(ring.CapacityFor(len(sli)))
+		copy(d.buf, sli)
+		d.size = len(sli)
+	}
+	return d
+}
+
+// FromSeq creates and returns a new Deque containing the elements yielded by seq.
+// Elements are pushed to the back in the order they are yielded.
+// Time complexity: O(n) where n is the number of elements yielded.
+//
+// Parameters:
+//   - seq: iterator of elements to initialize the deque with
+//
+// Returns:
+//   - a new Deque containing the yielded elements
+//
+// Example:
+//
+//	d := FromSeq(slices.Values([]int{1, 2, 3}))  // Creates deque with 1 at front
+func FromSeq[T any](seq iter.Seq[T]) *Deque[T] {
+	d := New[T](nil)
+	for v := range seq {
+		d.PushBack(v)
+	}
+	return d
+}
+
+// PushFront adds an element to the front of the deque, growing the buffer if it is full.
+// Time complexity: O(1) amortized.
+//
+// Parameters:
+//   - value: the element to add
+//
+// Example:
+//
+//	d.PushFront(42)  // 42 is now the front element
+func (d *Deque[T]) PushFront(value T) {
+	d.grow()
+	d.head = (d.head - 1) & (len(d.buf) - 1)
+	d.buf[d.head] = value
+	d.size++
+}
+
+// PushBack adds an element to the back of the deque, growing the buffer if it is full.
+// Time complexity: O(1) amortized.
+//
+// Parameters:
+//   - value: the element to add
+//
+// Example:
+//
+//	d.PushBack(42)  // 42 is now the back element
+func (d *Deque[T]) PushBack(value T) {
+	d.grow()
+	d.buf[d.index(d.size)] = value
+	d.size++
+}
+
+// PopFront removes and returns the front element of the deque.
+// If the deque is empty, returns the zero value of type T.
+// Time complexity: O(1) amortized.
+//
+// Returns:
+//   - the front element, or zero value if deque is empty
+//
+// Example:
+//
+//	value := d.PopFront()  // Remove and return front element
+func (d *Deque[T]) PopFront() T {
+	value, _ := d.TryPopFront()
+	return value
+}
+
+// PopBack removes and returns the back element of the deque.
+// If the deque is empty, returns the zero value of type T.
+// Time complexity: O(1) amortized.
+//
+// Returns:
+//   - the back element, or zero value if deque is empty
+//
+// Example:
+//
+//	value := d.PopBack()  // Remove and return back element
+func (d *Deque[T]) PopBack() T {
+	value, _ := d.TryPopBack()
+	return value
+}
+
+// PeekFront returns the front element of the deque without removing it.
+// If the deque is empty, returns the zero value of type T.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the front element, or zero value if deque is empty
+//
+// Example:
+//
+//	front := d.PeekFront()  // Look at front element without removing it
+func (d *Deque[T]) PeekFront() T {
+	value, _ := d.TryPeekFront()
+	return value
+}
+
+// PeekBack returns the back element of the deque without removing it.
+// If the deque is empty, returns the zero value of type T.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the back element, or zero value if deque is empty
+//
+// Example:
+//
+//	back := d.PeekBack()  // Look at back element without removing it
+func (d *Deque[T]) PeekBack() T {
+	value, _ := d.TryPeekBack()
+	return value
+}
+
+// TryPopFront removes and returns the front element of the deque.
+// Unlike PopFront, it reports whether an element was actually removed.
+// Time complexity: O(1) amortized.
+//
+// Returns:
+//   - value: the front element, or zero value if deque is empty
+//   - ok: true if an element was removed, false if the deque was empty
+//
+// Example:
+//
+//	if value, ok := d.TryPopFront(); ok {
+//	    fmt.Printf("Popped: %v\n", value)
+//	}
+func (d *Deque[T]) TryPopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	value := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	d.shrink()
+	return value, true
+}
+
+// TryPopBack removes and returns the back element of the deque.
+// Unlike PopBack, it reports whether an element was actually removed.
+// Time complexity: O(1) amortized.
+//
+// Returns:
+//   - value: the back element, or zero value if deque is empty
+//   - ok: true if an element was removed, false if the deque was empty
+//
+// Example:
+//
+//	if value, ok := d.TryPopBack(); ok {
+//	    fmt.Printf("Popped: %v\n", value)
+//	}
+func (d *Deque[T]) TryPopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	i := d.index(d.size - 1)
+	value := d.buf[i]
+	d.buf[i] = zero
+	d.size--
+	d.shrink()
+	return value, true
+}
+
+// TryPeekFront returns the front element of the deque without removing it.
+// Unlike PeekFront, it reports whether the deque had an element.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the front element, or zero value if deque is empty
+//   - ok: true if the deque is not empty, false otherwise
+func (d *Deque[T]) TryPeekFront() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// TryPeekBack returns the back element of the deque without removing it.
+// Unlike PeekBack, it reports whether the deque had an element.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the back element, or zero value if deque is empty
+//   - ok: true if the deque is not empty, false otherwise
+func (d *Deque[T]) TryPeekBack() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(d.size-1)], true
+}
+
+// MustPopFront removes and returns the front element of the deque.
+// It panics with an error wrapping errs.ErrEmpty if the deque is empty.
+// Time complexity: O(1) amortized.
+//
+// Returns:
+//   - the front element
+func (d *Deque[T]) MustPopFront() T {
+	value, ok := d.TryPopFront()
+	if !ok {
+		panic(fmt.Errorf("deque: PopFront: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// MustPopBack removes and returns the back element of the deque.
+// It panics with an error wrapping errs.ErrEmpty if the deque is empty.
+// Time complexity: O(1) amortized.
+//
+// Returns:
+//   - the back element
+func (d *Deque[T]) MustPopBack() T {
+	value, ok := d.TryPopBack()
+	if !ok {
+		panic(fmt.Errorf("deque: PopBack: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// MustPeekFront returns the front element of the deque without removing it.
+// It panics with an error wrapping errs.ErrEmpty if the deque is empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the front element
+func (d *Deque[T]) MustPeekFront() T {
+	value, ok := d.TryPeekFront()
+	if !ok {
+		panic(fmt.Errorf("deque: PeekFront: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// MustPeekBack returns the back element of the deque without removing it.
+// It panics with an error wrapping errs.ErrEmpty if the deque is empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the back element
+func (d *Deque[T]) MustPeekBack() T {
+	value, ok := d.TryPeekBack()
+	if !ok {
+		panic(fmt.Errorf("deque: PeekBack: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// At returns the element at position i, where 0 is the front and Size()-1 is the back.
+// Like slice indexing, it panics if i is out of range.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - i: the position of the element, in the range [0, Size())
+//
+// Returns:
+//   - the element at position i
+//
+// Example:
+//
+//	d := New([]int{10, 20, 30})
+//	second := d.At(1)  // 20
+func (d *Deque[T]) At(i int) T {
+	d.checkIndex("At", i)
+	return d.buf[d.index(i)]
+}
+
+// Set replaces the element at position i, where 0 is the front and Size()-1 is the back.
+// Like slice indexing, it panics if i is out of range.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - i: the position of the element, in the range [0, Size())
+//   - value: the new element
+//
+// Example:
+//
+//	d.Set(0, 42)  // Replace the front element
+func (d *Deque[T]) Set(i int, value T) {
+	d.checkIndex("Set", i)
+	d.buf[d.index(i)] = value
+}
+
+// Rotate rotates the deque n steps to the back: the last n elements move to the front,
+// in order. A negative n rotates to the front, moving the first -n elements to the back.
+// Rotating an empty deque, or by a multiple of its size, has no effect.
+// Time complexity: O(min(k, n-k)) where k is the effective rotation, or O(1) when the buffer is full.
+//
+// Parameters:
+//   - n: the number of steps to rotate, positive towards the back and negative towards the front
+//
+// Example:
+//
+//	d := New([]int{1, 2, 3, 4, 5})
+//	d.Rotate(2)   // 4, 5, 1, 2, 3
+//	d.Rotate(-2)  // 1, 2, 3, 4, 5
+func (d *Deque[T]) Rotate(n int) {
+	if d.size <= 1 {
+		return
+	}
+	// Normalize to a rotation towards the back in [0, size), then pick the shorter direction.
+	n %= d.size
+	if n < 0 {
+		n += d.size
+	}
+	if n == 0 {
+		return
+	}
+	if d.size == len(d.buf) {
+		// No free slots, so moving the head is the whole rotation.
+		d.head = d.index(d.size - n)
+		return
+	}
+	mask := len(d.buf) - 1
+	var zero T
+	if n <= d.size/2 {
+		for ; n > 0; n-- {
+			back := (d.head + d.size - 1) & mask
+			d.head = (d.head - 1) & mask
+			d.buf[d.head] = d.buf[back]
+			d.buf[back] = zero
+		}
+		return
+	}
+	for n = d.size - n; n > 0; n-- {
+		d.buf[(d.head+d.size)&mask] = d.buf[d.head]
+		d.buf[d.head] = zero
+		d.head = (d.head + 1) & mask
+	}
+}
+
+// Size returns the number of elements in the deque.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the number of elements in the deque
+func (d *Deque[T]) Size() int {
+	return d.size
+}
+
+// IsEmpty returns true if the deque contains no elements.
+// Time complexity: O(1).
+//
+// Returns:
+//   - true if the deque is empty, false otherwise
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// ToSlice returns a slice containing all elements from front to back.
+// The returned slice is a copy and modifications to it will not affect the deque.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - a slice containing all deque elements from front to back
+func (d *Deque[T]) ToSlice() []T {
+	slice := make([]T, d.size)
+	ring.CopyTo(slice, d.buf, d.head, d.size)
+	return slice
+}
+
+// All returns an iterator over the elements of the deque from front to back.
+// The deque must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, with no allocation of an intermediate slice.
+//
+// Returns:
+//   - an iter.Seq yielding the deque elements from front to back
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the deque from back to front.
+// The deque must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, with no allocation of an intermediate slice.
+//
+// Returns:
+//   - an iter.Seq yielding the deque elements from back to front
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all elements from the deque, making it empty and releasing its buffer.
+// Time complexity: O(1).
+func (d *Deque[T]) Clear() {
+	d.buf = nil
+	d.head = 0
+	d.size = 0
+}
+
+// index maps position i, counted from the front, to its slot in the buffer.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) & (len(d.buf) - 1)
+}
+
+// checkIndex panics if i is not a valid position for the named method.
+func (d *Deque[T]) checkIndex(method string, i int) {
+	if i < 0 || i >= d.size {
+		panic(fmt.Sprintf("deque: %s: index %d out of range [0:%d]", method, i, d.size))
+	}
+}
+
+// grow doubles the buffer if it has no free slot.
+func (d *Deque[T]) grow() {
+	if d.size == len(d.buf) {
+		d.resize(max(2*len(d.buf), ring.MinCapacity))
+	}
+}
+
+// shrink halves the buffer once it is only a quarter full.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > ring.MinCapacity && d.size <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+// resize moves the elements into a new buffer of the given power-of-two capacity,
+// placing the front element at index 0.
+func (d *Deque[T]) resize(capacity int) {
+	d.buf = ring.Resize(d.buf, d.head, d.size, capacity)
+	d.head = 0
+}
@@ -0,0 +1,461 @@
+package deque
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/thefrost13/gollections/errs"
+	"github.com/thefrost13/gollections/internal/ring"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("New with nil slice", func(t *testing.T) {
+		d := New[int](nil)
+		if d == nil {
+			t.Fatal("Expected deque to be initialized, got nil")
+		}
+		if d.Size() != 0 {
+			t.Errorf("Expected size 0, got %d", d.Size())
+		}
+		if !d.IsEmpty() {
+			t.Error("Expected deque to be empty")
+		}
+	})
+
+	t.Run("New with multiple elements", func(t *testing.T) {
+		values := []int{1, 2, 3, 4, 5}
+		d := New(values)
+		if d.Size() != len(values) {
+			t.Errorf("Expected size %d, got %d", len(values), d.Size())
+		}
+		if d.PeekFront() != 1 {
+			t.Errorf("Expected front 1, got %d", d.PeekFront())
+		}
+		if d.PeekBack() != 5 {
+			t.Errorf("Expected back 5, got %d", d.PeekBack())
+		}
+		if !reflect.DeepEqual(d.ToSlice(), values) {
+			t.Errorf("Expected %v, got %v", values, d.ToSlice())
+		}
+	})
+
+	t.Run("New does not share the slice", func(t *testing.T) {
+		values := []int{1, 2, 3}
+		d := New(values)
+		values[0] = 100
+		if d.PeekFront() != 1 {
+			t.Errorf("Expected front 1, got %d", d.PeekFront())
+		}
+	})
+
+	t.Run("FromSeq", func(t *testing.T) {
+		d := FromSeq(slices.Values([]string{"a", "b", "c"}))
+		expected := []string{"a", "b", "c"}
+		if !reflect.DeepEqual(d.ToSlice(), expected) {
+			t.Errorf("Expected %v, got %v", expected, d.ToSlice())
+		}
+	})
+}
+
+func TestDequePushPop(t *testing.T) {
+	t.Run("PushBack and PopFront behave as a queue", func(t *testing.T) {
+		d := New[int](nil)
+		for i := 0; i < 20; i++ {
+			d.PushBack(i)
+		}
+		for i := 0; i < 20; i++ {
+			if v := d.PopFront(); v != i {
+				t.Fatalf("Expected %d, got %d", i, v)
+			}
+		}
+	})
+
+	t.Run("PushBack and PopBack behave as a stack", func(t *testing.T) {
+		d := New[int](nil)
+		for i := 0; i < 20; i++ {
+			d.PushBack(i)
+		}
+		for i := 19; i >= 0; i-- {
+			if v := d.PopBack(); v != i {
+				t.Fatalf("Expected %d, got %d", i, v)
+			}
+		}
+	})
+
+	t.Run("PushFront and PopBack behave as a queue", func(t *testing.T) {
+		d := New[int](nil)
+		for i := 0; i < 20; i++ {
+			d.PushFront(i)
+		}
+		for i := 0; i < 20; i++ {
+			if v := d.PopBack(); v != i {
+				t.Fatalf("Expected %d, got %d", i, v)
+			}
+		}
+	})
+
+	t.Run("Mixed ends", func(t *testing.T) {
+		d := New[int](nil)
+		d.PushBack(2)
+		d.PushFront(1)
+		d.PushBack(3)
+		d.PushFront(0)
+		expected := []int{0, 1, 2, 3}
+		if !reflect.DeepEqual(d.ToSlice(), expected) {
+			t.Errorf("Expected %v, got %v", expected, d.ToSlice())
+		}
+		if d.PopFront() != 0 || d.PopBack() != 3 {
+			t.Error("Expected to pop 0 from front and 3 from back")
+		}
+		if d.PeekFront() != 1 || d.PeekBack() != 2 {
+			t.Errorf("Expected front 1 and back 2, got %d and %d", d.PeekFront(), d.PeekBack())
+		}
+	})
+
+	t.Run("Empty deque returns zero values", func(t *testing.T) {
+		d := New[string](nil)
+		if d.PopFront() != "" || d.PopBack() != "" || d.PeekFront() != "" || d.PeekBack() != "" {
+			t.Error("Expected zero values from empty deque")
+		}
+	})
+
+	t.Run("Zero value deque is usable", func(t *testing.T) {
+		var d Deque[int]
+		d.PushFront(1)
+		if v := d.PopBack(); v != 1 {
+			t.Errorf("Expected 1, got %d", v)
+		}
+	})
+
+	t.Run("Pop clears the vacated slot", func(t *testing.T) {
+		d := New([]*int{new(int), new(int), new(int)})
+		front, back := d.head, d.index(d.size-1)
+		d.PopFront()
+		d.PopBack()
+		if d.buf[front] != nil || d.buf[back] != nil {
+			t.Error("Expected popped slots to be cleared")
+		}
+	})
+
+	t.Run("Grows and shrinks", func(t *testing.T) {
+		d := New[int](nil)
+		for i := 0; i < 512; i++ {
+			d.PushFront(i)
+		}
+		if len(d.buf) != 512 {
+			t.Errorf("Expected capacity 512, got %d", len(d.buf))
+		}
+		for d.Size() > 1 {
+			d.PopBack()
+		}
+		if len(d.buf) != ring.MinCapacity {
+			t.Errorf("Expected capacity %d, got %d", ring.MinCapacity, len(d.buf))
+		}
+		if d.PeekFront() != 511 {
+			t.Errorf("Expected remaining element 511, got %d", d.PeekFront())
+		}
+	})
+
+	t.Run("Matches slice model under mixed operations", func(t *testing.T) {
+		d := New[int](nil)
+		var model []int
+		for i := 0; i < 5000; i++ {
+			switch i % 7 {
+			case 0, 3:
+				d.PushFront(i)
+				model = slices.Insert(model, 0, i)
+			case 1, 4, 6:
+				d.PushBack(i)
+				model = append(model, i)
+			case 2:
+				if len(model) > 0 {
+					if v := d.PopFront(); v != model[0] {
+						t.Fatalf("At step %d, expected %d, got %d", i, model[0], v)
+					}
+					model = model[1:]
+				}
+			case 5:
+				if len(model) > 0 {
+					if v := d.PopBack(); v != model[len(model)-1] {
+						t.Fatalf("At step %d, expected %d, got %d", i, model[len(model)-1], v)
+					}
+					model = model[:len(model)-1]
+				}
+			}
+		}
+		if !slices.Equal(d.ToSlice(), model) {
+			t.Error("Expected deque contents to match the model")
+		}
+	})
+}
+
+func TestDequeAt(t *testing.T) {
+	d := New[int](nil)
+	for i := 3; i < 6; i++ {
+		d.PushBack(i)
+	}
+	for i := 2; i >= 0; i-- {
+		d.PushFront(i)
+	}
+
+	t.Run("At", func(t *testing.T) {
+		for i := 0; i < 6; i++ {
+			if v := d.At(i); v != i {
+				t.Errorf("At(%d): expected %d, got %d", i, i, v)
+			}
+		}
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		d.Set(0, 100)
+		d.Set(5, 500)
+		if d.PeekFront() != 100 || d.PeekBack() != 500 {
+			t.Errorf("Expected front 100 and back 500, got %d and %d", d.PeekFront(), d.PeekBack())
+		}
+	})
+
+	t.Run("Out of range panics", func(t *testing.T) {
+		for _, i := range []int{-1, 6} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Expected At(%d) to panic", i)
+					}
+				}()
+				d.At(i)
+			}()
+		}
+	})
+}
+
+func TestDequeRotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{"Rotate by zero", 0, []int{1, 2, 3, 4, 5}},
+		{"Rotate towards back", 2, []int{4, 5, 1, 2, 3}},
+		{"Rotate towards back by more than half", 4, []int{2, 3, 4, 5, 1}},
+		{"Rotate towards front", -2, []int{3, 4, 5, 1, 2}},
+		{"Rotate by size", 5, []int{1, 2, 3, 4, 5}},
+		{"Rotate by more than size", 7, []int{4, 5, 1, 2, 3}},
+		{"Rotate by less than negative size", -6, []int{2, 3, 4, 5, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := New([]int{1, 2, 3, 4, 5})
+			d.Rotate(tt.n)
+			if !reflect.DeepEqual(d.ToSlice(), tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, d.ToSlice())
+			}
+		})
+
+		t.Run(tt.name+" with full buffer", func(t *testing.T) {
+			// Eight elements fill the buffer exactly; cycling one wraps the head as well.
+			d := New([]int{0, 1, 2, 3, 4, 5, 6, 7})
+			d.PopFront()
+			d.PushBack(8)
+			if len(d.buf) != d.size || d.head == 0 {
+				t.Fatalf("Expected full wrapped buffer, got size %d, capacity %d, head %d", d.size, len(d.buf), d.head)
+			}
+			model := []int{1, 2, 3, 4, 5, 6, 7, 8}
+			k := ((tt.n % len(model)) + len(model)) % len(model)
+			model = append(model[len(model)-k:], model[:len(model)-k]...)
+			d.Rotate(tt.n)
+			if !reflect.DeepEqual(d.ToSlice(), model) {
+				t.Errorf("Expected %v, got %v", model, d.ToSlice())
+			}
+		})
+	}
+
+	t.Run("Rotate empty and single element deque", func(t *testing.T) {
+		d := New[int](nil)
+		d.Rotate(3)
+		if !d.IsEmpty() {
+			t.Error("Expected deque to remain empty")
+		}
+		d.PushBack(1)
+		d.Rotate(-4)
+		if d.PeekFront() != 1 {
+			t.Errorf("Expected 1, got %d", d.PeekFront())
+		}
+	})
+}
+
+func TestDequeIterators(t *testing.T) {
+	d := New[int](nil)
+	for i := 3; i < 6; i++ {
+		d.PushBack(i)
+	}
+	for i := 2; i >= 0; i-- {
+		d.PushFront(i)
+	}
+
+	t.Run("All", func(t *testing.T) {
+		got := slices.Collect(d.All())
+		expected := []int{0, 1, 2, 3, 4, 5}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("Backward", func(t *testing.T) {
+		got := slices.Collect(d.Backward())
+		expected := []int{5, 4, 3, 2, 1, 0}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("Early break", func(t *testing.T) {
+		var got []int
+		for v := range d.Backward() {
+			if v == 3 {
+				break
+			}
+			got = append(got, v)
+		}
+		if !reflect.DeepEqual(got, []int{5, 4}) {
+			t.Errorf("Expected [5 4], got %v", got)
+		}
+	})
+}
+
+func TestDequeClear(t *testing.T) {
+	d := New([]int{1, 2, 3})
+	d.Clear()
+	if !d.IsEmpty() {
+		t.Error("Expected deque to be empty after Clear")
+	}
+	if len(d.ToSlice()) != 0 {
+		t.Errorf("Expected empty slice, got %v", d.ToSlice())
+	}
+	d.PushFront(4)
+	if d.PeekBack() != 4 {
+		t.Errorf("Expected 4, got %d", d.PeekBack())
+	}
+}
+
+func recoverError(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, _ = r.(error)
+		}
+	}()
+	fn()
+	return nil
+}
+
+func TestDequeTryAndMustOperations(t *testing.T) {
+	t.Run("Try on empty deque", func(t *testing.T) {
+		d := New[int](nil)
+		if _, ok := d.TryPopFront(); ok {
+			t.Error("Expected TryPopFront to report false")
+		}
+		if _, ok := d.TryPopBack(); ok {
+			t.Error("Expected TryPopBack to report false")
+		}
+		if _, ok := d.TryPeekFront(); ok {
+			t.Error("Expected TryPeekFront to report false")
+		}
+		if _, ok := d.TryPeekBack(); ok {
+			t.Error("Expected TryPeekBack to report false")
+		}
+	})
+
+	t.Run("Try distinguishes stored zero value", func(t *testing.T) {
+		d := New([]int{0, 0})
+		if v, ok := d.TryPopFront(); !ok || v != 0 {
+			t.Errorf("Expected (0, true), got (%d, %v)", v, ok)
+		}
+		if v, ok := d.TryPeekBack(); !ok || v != 0 {
+			t.Errorf("Expected (0, true), got (%d, %v)", v, ok)
+		}
+	})
+
+	t.Run("Must on empty deque panics with ErrEmpty", func(t *testing.T) {
+		d := New[int](nil)
+		for name, fn := range map[string]func(){
+			"MustPopFront":  func() { d.MustPopFront() },
+			"MustPopBack":   func() { d.MustPopBack() },
+			"MustPeekFront": func() { d.MustPeekFront() },
+			"MustPeekBack":  func() { d.MustPeekBack() },
+		} {
+			if err := recoverError(fn); !errors.Is(err, errs.ErrEmpty) {
+				t.Errorf("%s: expected ErrEmpty panic, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("Must on non-empty deque", func(t *testing.T) {
+		d := New([]int{1, 2, 3})
+		if v := d.MustPeekFront(); v != 1 {
+			t.Errorf("Expected 1, got %d", v)
+		}
+		if v := d.MustPeekBack(); v != 3 {
+			t.Errorf("Expected 3, got %d", v)
+		}
+		if v := d.MustPopFront(); v != 1 {
+			t.Errorf("Expected 1, got %d", v)
+		}
+		if v := d.MustPopBack(); v != 3 {
+			t.Errorf("Expected 3, got %d", v)
+		}
+	})
+}
+
+// Benchmark tests
+func BenchmarkDequePushBack(b *testing.B) {
+	d := New[int](nil)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+	}
+}
+
+func BenchmarkDequePushFront(b *testing.B) {
+	d := New[int](nil)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		d.PushFront(i)
+	}
+}
+
+func BenchmarkDequePopFront(b *testing.B) {
+	d := New[int](nil)
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.PopFront()
+	}
+}
+
+func BenchmarkDequeAt(b *testing.B) {
+	d := New(make([]int, 1024))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		d.At(i & 1023)
+	}
+}
+
+func BenchmarkDequeSlidingWindow(b *testing.B) {
+	d := New[int](nil)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+		if d.Size() > 64 {
+			d.PopFront()
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// Package errs defines the sentinel errors shared by the collections in the gollections library.
+// Callers should compare against these values with errors.Is, since collections may wrap them
+// with additional context.
+package errs
+
+import "errors"
+
+// ErrEmpty is reported when an element is requested from a collection that contains no elements.
+// The Must-style methods of Stack, Queue and PriorityQueue panic with an error wrapping ErrEmpty.
+//
+// Example:
+//
+//	defer func() {
+//	    if r := recover(); r != nil {
+//	        if err, ok := r.(error); ok && errors.Is(err, errs.ErrEmpty) {
+//	            fmt.Println("stack was empty")
+//	        }
+//	    }
+//	}()
+//	value := s.MustPop()
+var ErrEmpty = errors.New("collection is empty")
+
+// ErrClosed is reported when an operation is attempted on a collection that has been closed,
+// such as putting into or taking from a drained queue.BlockingQueue after Close.
+var ErrClosed = errors.New("collection is closed")
+
+// ErrFull is reported when an element is offered to a bounded collection that has no room for it,
+// such as putting into a full queue.BoundedQueue with the Reject policy.
+var ErrFull = errors.New("collection is full")
@@ -0,0 +1,96 @@
+package hashset
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON implements json.Marshaler, encoding the set as a JSON array of its elements.
+// The order of the elements in the array is unspecified.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - the JSON encoding of the set
+//   - an error if an element cannot be marshaled
+//
+// Example:
+//
+//	data, _ := json.Marshal(New([]int{1, 2, 3}))  // [1,2,3] in some order
+func (s HashSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It replaces the contents of the set with the elements of a JSON array; duplicates are ignored.
+// A JSON null leaves the set unchanged, and if an error occurs the set is left unchanged.
+// Time complexity: O(n) where n is the number of array elements.
+//
+// Parameters:
+//   - data: the JSON encoding of an array
+//
+// Returns:
+//   - an error if data is not an array of T
+//
+// Example:
+//
+//	var set HashSet[string]
+//	_ = json.Unmarshal([]byte(`["a","b"]`), &set)
+func (s *HashSet[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	s.replace(elements)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using encoding/gob.
+// encoding/gob uses it as well, so a HashSet can be sent with a gob.Encoder directly.
+// The order of the encoded elements is unspecified.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - the binary encoding of the set
+//   - an error if an element cannot be encoded by gob
+func (s HashSet[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.ToSlice()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the set
+// with the elements decoded from data. If an error occurs the set is left unchanged.
+// Time complexity: O(n) where n is the number of encoded elements.
+//
+// Parameters:
+//   - data: the output of MarshalBinary
+//
+// Returns:
+//   - an error if data is not a valid encoding
+func (s *HashSet[T]) UnmarshalBinary(data []byte) error {
+	var elements []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements); err != nil {
+		return err
+	}
+	s.replace(elements)
+	return nil
+}
+
+// replace clears the set, allocating it if nil, and adds the given elements.
+// The existing map is reused so other references to the set observe the new contents.
+func (s *HashSet[T]) replace(elements []T) {
+	if *s == nil {
+		*s = make(HashSet[T], len(elements))
+	} else {
+		clear(*s)
+	}
+	for _, v := range elements {
+		(*s)[v] = true
+	}
+}
@@ -0,0 +1,197 @@
+package hashset
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestHashSetJSON(t *testing.T) {
+	t.Run("Marshal as array", func(t *testing.T) {
+		data, err := json.Marshal(New([]int{3, 1, 2}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var elements []int
+		if err := json.Unmarshal(data, &elements); err != nil {
+			t.Fatalf("Expected a JSON array, got %s", data)
+		}
+		if !reflect.DeepEqual(sortedSlice(New(elements)), []int{1, 2, 3}) {
+			t.Errorf("Expected elements [1 2 3], got %v", elements)
+		}
+	})
+
+	t.Run("Unmarshal replaces contents and drops duplicates", func(t *testing.T) {
+		set := New([]int{100})
+		alias := set
+		if err := json.Unmarshal([]byte(`[1,2,2,3]`), &set); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(sortedSlice(set), []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", sortedSlice(set))
+		}
+		if !alias.Equals(set) {
+			t.Error("Expected existing references to observe the decoded contents")
+		}
+	})
+
+	t.Run("Unmarshal into nil set and struct field", func(t *testing.T) {
+		var doc struct {
+			Tags HashSet[string] `json:"tags"`
+		}
+		if err := json.Unmarshal([]byte(`{"tags":["a","b"]}`), &doc); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !doc.Tags.Equals(New([]string{"a", "b"})) {
+			t.Errorf("Expected {a b}, got %v", doc.Tags.ToSlice())
+		}
+	})
+
+	t.Run("null and errors leave the set unchanged", func(t *testing.T) {
+		set := New([]int{1})
+		if err := set.UnmarshalJSON([]byte("null")); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if err := json.Unmarshal([]byte(`["x"]`), &set); err == nil {
+			t.Error("Expected error for wrong element type")
+		}
+		if !set.Equals(New([]int{1})) {
+			t.Errorf("Expected {1}, got %v", set.ToSlice())
+		}
+	})
+}
+
+func TestHashSetBinary(t *testing.T) {
+	t.Run("MarshalBinary round trip", func(t *testing.T) {
+		set := New([]string{"a", "b", "c"})
+		data, err := set.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var decoded HashSet[string]
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !decoded.Equals(set) {
+			t.Errorf("Expected %v, got %v", set.ToSlice(), decoded.ToSlice())
+		}
+	})
+
+	t.Run("gob encoder uses the binary encoding", func(t *testing.T) {
+		type doc struct {
+			Name string
+			IDs  HashSet[int]
+		}
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(doc{"ids", New([]int{1, 2})}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var decoded doc
+		if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if decoded.Name != "ids" || !decoded.IDs.Equals(New([]int{1, 2})) {
+			t.Errorf("Unexpected decoded value %+v", decoded)
+		}
+	})
+
+	t.Run("invalid data leaves the set unchanged", func(t *testing.T) {
+		set := New([]int{1})
+		if err := set.UnmarshalBinary([]byte("not gob")); err == nil {
+			t.Error("Expected error for invalid data")
+		}
+		if !set.Equals(New([]int{1})) {
+			t.Errorf("Expected {1}, got %v", set.ToSlice())
+		}
+	})
+}
+
+func TestSyncHashSetEncoding(t *testing.T) {
+	set := NewSync(New([]int{1, 2}))
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var decoded SyncHashSet[int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Size() != 2 || !decoded.Contains(1) || !decoded.Contains(2) {
+		t.Errorf("Unexpected decoded set %v", decoded.ToSlice())
+	}
+
+	data, err = set.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var fromBinary SyncHashSet[int]
+	if err := fromBinary.UnmarshalBinary(data); err != nil || fromBinary.Size() != 2 {
+		t.Errorf("Expected 2 elements, got %d (%v)", fromBinary.Size(), err)
+	}
+}
+
+func TestSyncHashSetZeroValueEncoding(t *testing.T) {
+	var zero SyncHashSet[int]
+	data, err := json.Marshal(&zero)
+	if err != nil || string(data) != "[]" {
+		t.Errorf("Expected [], got %s (%v)", data, err)
+	}
+	data, err = zero.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var fromBinary SyncHashSet[int]
+	if err := fromBinary.UnmarshalBinary(data); err != nil || !fromBinary.IsEmpty() {
+		t.Errorf("Expected empty set, got size %d (%v)", fromBinary.Size(), err)
+	}
+}
+
+func FuzzHashSetRoundTrip(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 2, 3, 3})
+	f.Add([]byte("hello, world"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		set := New(fuzzInts(data))
+
+		encoded, err := json.Marshal(set)
+		if err != nil {
+			t.Fatalf("MarshalJSON: %v", err)
+		}
+		var fromJSON HashSet[int]
+		if err := json.Unmarshal(encoded, &fromJSON); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", encoded, err)
+		}
+		if !fromJSON.Equals(set) {
+			t.Errorf("JSON round trip: expected %v, got %v", set.ToSlice(), fromJSON.ToSlice())
+		}
+
+		encoded, err = set.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary: %v", err)
+		}
+		var fromBinary HashSet[int]
+		if err := fromBinary.UnmarshalBinary(encoded); err != nil {
+			t.Fatalf("UnmarshalBinary: %v", err)
+		}
+		if !fromBinary.Equals(set) {
+			t.Errorf("Binary round trip: expected %v, got %v", set.ToSlice(), fromBinary.ToSlice())
+		}
+
+		// Arbitrary input must never panic.
+		var junk HashSet[int]
+		_ = junk.UnmarshalJSON(data)
+		_ = junk.UnmarshalBinary(data)
+	})
+}
+
+// fuzzInts turns fuzzer bytes into signed integers spread over a wider range than a byte.
+func fuzzInts(data []byte) []int {
+	ints := make([]int, len(data))
+	for i, b := range data {
+		ints[i] = int(int8(b)) * 1000
+	}
+	return ints
+}
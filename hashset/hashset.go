@@ -2,6 +2,8 @@
 // unique values and provides fast membership testing, insertion, and deletion.
 package hashset
 
+import "iter"
+
 // HashSet is a generic set data structure that stores unique values of comparable types.
 // It's implemented using Go's built-in map for O(1) average-case operations.
 // The zero value is ready to use but New should be preferred for initialization.
@@ -33,6 +35,26 @@ func New[T comparable](sli []T) HashSet[T] {
 	return set
 }
 
+// FromSeq creates and returns a new HashSet containing the unique elements yielded by seq.
+// Time complexity: O(n) where n is the number of elements yielded.
+//
+// Parameters:
+//   - seq: the sequence of elements to initialize the set with
+//
+// Returns:
+//   - a new HashSet containing the unique elements from the sequence
+//
+// Example:
+//
+//	set := FromSeq(slices.Values([]int{1, 2, 2, 3}))  // Creates set with {1, 2, 3}
+func FromSeq[T comparable](seq iter.Seq[T]) HashSet[T] {
+	set := make(HashSet[T])
+	for v := range seq {
+		set[v] = true
+	}
+	return set
+}
+
 // Add inserts an element into the set.
 // If the element already exists, the operation is a no-op.
 // Time complexity: O(1) average case.
@@ -144,6 +166,29 @@ func (s HashSet[T]) ToSlice() []T {
 	return slice
 }
 
+// All returns an iterator over the elements of the set.
+// The iteration order is not specified and may differ between calls.
+// The set must not be modified during iteration other than by removing the current element.
+// Time complexity: O(n) for a full iteration, with no allocation of an intermediate slice.
+//
+// Returns:
+//   - an iter.Seq yielding each element of the set
+//
+// Example:
+//
+//	for v := range set.All() {
+//	    fmt.Println(v)
+//	}
+func (s HashSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for key := range s {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
 // Equals checks if this set contains exactly the same elements as another set.
 // The comparison is based on set membership, not on the order of elements.
 // Time complexity: O(n) where n is the number of elements in the larger set.
@@ -172,3 +217,270 @@ func (s HashSet[T]) Equals(other HashSet[T]) bool {
 	}
 	return true
 }
+
+// Clone returns a shallow copy of the set.
+// The returned set is independent and modifications to it will not affect the original.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - a new HashSet containing the same elements
+//
+// Example:
+//
+//	backup := set.Clone()
+//	set.Clear()  // backup still holds the original elements
+func (s HashSet[T]) Clone() HashSet[T] {
+	clone := make(HashSet[T], len(s))
+	for key := range s {
+		clone[key] = true
+	}
+	return clone
+}
+
+// Union returns a new set containing every element that is in this set or in any of the others.
+// Neither this set nor the others are modified.
+// Time complexity: O(n + m) where n is the size of this set and m is the combined size of the others.
+//
+// Parameters:
+//   - others: the sets to combine with this set
+//
+// Returns:
+//   - a new HashSet containing the union of all sets
+//
+// Example:
+//
+//	a := New([]int{1, 2})
+//	b := New([]int{2, 3})
+//	c := New([]int{4})
+//	u := a.Union(b, c)  // {1, 2, 3, 4}
+func (s HashSet[T]) Union(others ...HashSet[T]) HashSet[T] {
+	result := s.Clone()
+	result.UnionWith(others...)
+	return result
+}
+
+// Intersection returns a new set containing only the elements present in this set and in all of the others.
+// The smallest operand is iterated, so the cost is bounded by the smallest set.
+// With no arguments, a copy of this set is returned.
+// Time complexity: O(k * m) where k is the size of the smallest set and m is the number of sets.
+//
+// Parameters:
+//   - others: the sets to intersect with this set
+//
+// Returns:
+//   - a new HashSet containing the intersection of all sets
+//
+// Example:
+//
+//	a := New([]int{1, 2, 3})
+//	b := New([]int{2, 3, 4})
+//	i := a.Intersection(b)  // {2, 3}
+func (s HashSet[T]) Intersection(others ...HashSet[T]) HashSet[T] {
+	smallest := s
+	for _, other := range others {
+		if len(other) < len(smallest) {
+			smallest = other
+		}
+	}
+
+	result := make(HashSet[T])
+	for key := range smallest {
+		if s.Contains(key) && containedInAll(key, others) {
+			result[key] = true
+		}
+	}
+	return result
+}
+
+// Difference returns a new set containing the elements of this set that are not in any of the others.
+// Neither this set nor the others are modified.
+// Time complexity: O(n + m) where n is the size of this set and m is the combined size of the others.
+//
+// Parameters:
+//   - others: the sets whose elements should be excluded
+//
+// Returns:
+//   - a new HashSet containing the difference
+//
+// Example:
+//
+//	a := New([]int{1, 2, 3})
+//	b := New([]int{2})
+//	d := a.Difference(b)  // {1, 3}
+func (s HashSet[T]) Difference(others ...HashSet[T]) HashSet[T] {
+	result := s.Clone()
+	result.ExceptWith(others...)
+	return result
+}
+
+// SymmetricDifference returns a new set containing the elements that are in exactly one of the two sets.
+// Neither set is modified.
+// Time complexity: O(n + m) where n and m are the sizes of the two sets.
+//
+// Parameters:
+//   - other: the HashSet to compare with
+//
+// Returns:
+//   - a new HashSet containing the symmetric difference
+//
+// Example:
+//
+//	a := New([]int{1, 2, 3})
+//	b := New([]int{3, 4})
+//	x := a.SymmetricDifference(b)  // {1, 2, 4}
+func (s HashSet[T]) SymmetricDifference(other HashSet[T]) HashSet[T] {
+	result := make(HashSet[T])
+	for key := range s {
+		if !other.Contains(key) {
+			result[key] = true
+		}
+	}
+	for key := range other {
+		if !s.Contains(key) {
+			result[key] = true
+		}
+	}
+	return result
+}
+
+// IsSubsetOf checks if every element of this set is also contained in another set.
+// The empty set is a subset of every set.
+// Time complexity: O(n) where n is the number of elements in this set.
+//
+// Parameters:
+//   - other: the potential superset
+//
+// Returns:
+//   - true if this set is a subset of other, false otherwise
+//
+// Example:
+//
+//	New([]int{1, 2}).IsSubsetOf(New([]int{1, 2, 3}))  // true
+func (s HashSet[T]) IsSubsetOf(other HashSet[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for key := range s {
+		if !other.Contains(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf checks if this set contains every element of another set.
+// Every set is a superset of the empty set.
+// Time complexity: O(m) where m is the number of elements in other.
+//
+// Parameters:
+//   - other: the potential subset
+//
+// Returns:
+//   - true if this set is a superset of other, false otherwise
+//
+// Example:
+//
+//	New([]int{1, 2, 3}).IsSupersetOf(New([]int{1, 2}))  // true
+func (s HashSet[T]) IsSupersetOf(other HashSet[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsDisjoint checks if this set and another set have no elements in common.
+// The smaller of the two sets is iterated.
+// Time complexity: O(min(n, m)) where n and m are the sizes of the two sets.
+//
+// Parameters:
+//   - other: the HashSet to compare with
+//
+// Returns:
+//   - true if the sets share no elements, false otherwise
+//
+// Example:
+//
+//	New([]int{1, 2}).IsDisjoint(New([]int{3, 4}))  // true
+func (s HashSet[T]) IsDisjoint(other HashSet[T]) bool {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	for key := range small {
+		if large.Contains(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// UnionWith adds every element of the given sets to this set in place.
+// Time complexity: O(m) where m is the combined size of the others.
+//
+// Parameters:
+//   - others: the sets whose elements should be added
+//
+// Example:
+//
+//	a := New([]int{1, 2})
+//	a.UnionWith(New([]int{3}), New([]int{4}))  // a is now {1, 2, 3, 4}
+func (s HashSet[T]) UnionWith(others ...HashSet[T]) {
+	for _, other := range others {
+		for key := range other {
+			s[key] = true
+		}
+	}
+}
+
+// IntersectWith removes from this set every element that is not present in all of the given sets.
+// With no arguments, the set is left unchanged.
+// Time complexity: O(n * m) where n is the size of this set and m is the number of sets.
+//
+// Parameters:
+//   - others: the sets to intersect with
+//
+// Example:
+//
+//	a := New([]int{1, 2, 3})
+//	a.IntersectWith(New([]int{2, 3, 4}))  // a is now {2, 3}
+func (s HashSet[T]) IntersectWith(others ...HashSet[T]) {
+	for key := range s {
+		if !containedInAll(key, others) {
+			delete(s, key)
+		}
+	}
+}
+
+// ExceptWith removes from this set every element that is present in any of the given sets.
+// For each operand, the smaller of this set and the operand is iterated.
+// Time complexity: O(sum of min(n, m_i)) where n is the size of this set and m_i the size of each other.
+//
+// Parameters:
+//   - others: the sets whose elements should be removed
+//
+// Example:
+//
+//	a := New([]int{1, 2, 3})
+//	a.ExceptWith(New([]int{2}))  // a is now {1, 3}
+func (s HashSet[T]) ExceptWith(others ...HashSet[T]) {
+	for _, other := range others {
+		if len(other) < len(s) {
+			for key := range other {
+				delete(s, key)
+			}
+			continue
+		}
+		for key := range s {
+			if other.Contains(key) {
+				delete(s, key)
+			}
+		}
+	}
+}
+
+// containedInAll reports whether value is a member of every set in sets.
+func containedInAll[T comparable](value T, sets []HashSet[T]) bool {
+	for _, set := range sets {
+		if !set.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
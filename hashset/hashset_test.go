@@ -2,6 +2,7 @@ package hashset
 
 import (
 	"reflect"
+	"slices"
 	"sort"
 	"testing"
 )
@@ -543,6 +544,276 @@ func TestHashSetIntegration(t *testing.T) {
 	})
 }
 
+func sortedSlice(s HashSet[int]) []int {
+	slice := s.ToSlice()
+	sort.Ints(slice)
+	return slice
+}
+
+func TestClone(t *testing.T) {
+	t.Run("clone is independent", func(t *testing.T) {
+		set := New([]int{1, 2, 3})
+		clone := set.Clone()
+		if !set.Equals(clone) {
+			t.Error("Expected clone to equal original")
+		}
+		clone.Add(4)
+		if set.Contains(4) {
+			t.Error("Expected original to be unaffected by clone modification")
+		}
+	})
+}
+
+func TestUnion(t *testing.T) {
+	t.Run("union of two sets", func(t *testing.T) {
+		a := New([]int{1, 2, 3})
+		b := New([]int{3, 4, 5})
+		result := a.Union(b)
+		expected := []int{1, 2, 3, 4, 5}
+		if !reflect.DeepEqual(sortedSlice(result), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(result))
+		}
+		if a.Size() != 3 || b.Size() != 3 {
+			t.Error("Expected operands to be unmodified")
+		}
+	})
+
+	t.Run("union of many sets", func(t *testing.T) {
+		a := New([]int{1})
+		result := a.Union(New([]int{2}), New([]int{3}), New[int](nil))
+		expected := []int{1, 2, 3}
+		if !reflect.DeepEqual(sortedSlice(result), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(result))
+		}
+	})
+
+	t.Run("union with no arguments copies", func(t *testing.T) {
+		a := New([]int{1, 2})
+		result := a.Union()
+		if !result.Equals(a) {
+			t.Error("Expected union with no arguments to equal original")
+		}
+	})
+}
+
+func TestIntersection(t *testing.T) {
+	t.Run("intersection of two sets", func(t *testing.T) {
+		a := New([]int{1, 2, 3, 4})
+		b := New([]int{3, 4, 5})
+		result := a.Intersection(b)
+		expected := []int{3, 4}
+		if !reflect.DeepEqual(sortedSlice(result), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(result))
+		}
+	})
+
+	t.Run("intersection of many sets", func(t *testing.T) {
+		a := New([]int{1, 2, 3, 4, 5})
+		b := New([]int{2, 3, 4})
+		c := New([]int{3, 4, 9})
+		result := a.Intersection(b, c)
+		expected := []int{3, 4}
+		if !reflect.DeepEqual(sortedSlice(result), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(result))
+		}
+	})
+
+	t.Run("intersection with empty set", func(t *testing.T) {
+		a := New([]int{1, 2, 3})
+		result := a.Intersection(New[int](nil))
+		if !result.IsEmpty() {
+			t.Errorf("Expected empty intersection, got %v", result.ToSlice())
+		}
+	})
+
+	t.Run("intersection with no arguments copies", func(t *testing.T) {
+		a := New([]int{1, 2})
+		result := a.Intersection()
+		if !result.Equals(a) {
+			t.Error("Expected intersection with no arguments to equal original")
+		}
+	})
+}
+
+func TestDifference(t *testing.T) {
+	t.Run("difference of two sets", func(t *testing.T) {
+		a := New([]int{1, 2, 3})
+		b := New([]int{2, 5})
+		result := a.Difference(b)
+		expected := []int{1, 3}
+		if !reflect.DeepEqual(sortedSlice(result), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(result))
+		}
+		if a.Size() != 3 {
+			t.Error("Expected receiver to be unmodified")
+		}
+	})
+
+	t.Run("difference of many sets", func(t *testing.T) {
+		a := New([]int{1, 2, 3, 4, 5})
+		result := a.Difference(New([]int{1}), New([]int{4, 5, 6, 7, 8, 9}))
+		expected := []int{2, 3}
+		if !reflect.DeepEqual(sortedSlice(result), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(result))
+		}
+	})
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	t.Run("symmetric difference", func(t *testing.T) {
+		a := New([]int{1, 2, 3})
+		b := New([]int{3, 4})
+		result := a.SymmetricDifference(b)
+		expected := []int{1, 2, 4}
+		if !reflect.DeepEqual(sortedSlice(result), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(result))
+		}
+	})
+
+	t.Run("symmetric difference of equal sets", func(t *testing.T) {
+		a := New([]int{1, 2})
+		if !a.SymmetricDifference(a.Clone()).IsEmpty() {
+			t.Error("Expected empty symmetric difference for equal sets")
+		}
+	})
+}
+
+func TestSubsetSuperset(t *testing.T) {
+	small := New([]int{1, 2})
+	large := New([]int{1, 2, 3})
+	other := New([]int{1, 4})
+	empty := New[int](nil)
+
+	if !small.IsSubsetOf(large) {
+		t.Error("Expected small to be subset of large")
+	}
+	if large.IsSubsetOf(small) {
+		t.Error("Expected large to not be subset of small")
+	}
+	if other.IsSubsetOf(large) {
+		t.Error("Expected other to not be subset of large")
+	}
+	if !empty.IsSubsetOf(small) {
+		t.Error("Expected empty set to be subset of any set")
+	}
+	if !small.IsSubsetOf(small) {
+		t.Error("Expected set to be subset of itself")
+	}
+	if !large.IsSupersetOf(small) {
+		t.Error("Expected large to be superset of small")
+	}
+	if small.IsSupersetOf(large) {
+		t.Error("Expected small to not be superset of large")
+	}
+	if !small.IsSupersetOf(empty) {
+		t.Error("Expected any set to be superset of empty set")
+	}
+}
+
+func TestIsDisjoint(t *testing.T) {
+	if !New([]int{1, 2}).IsDisjoint(New([]int{3, 4, 5})) {
+		t.Error("Expected sets to be disjoint")
+	}
+	if New([]int{1, 2, 3, 4}).IsDisjoint(New([]int{4})) {
+		t.Error("Expected sets to not be disjoint")
+	}
+	if !New[int](nil).IsDisjoint(New[int](nil)) {
+		t.Error("Expected empty sets to be disjoint")
+	}
+}
+
+func TestInPlaceOperations(t *testing.T) {
+	t.Run("union with", func(t *testing.T) {
+		a := New([]int{1, 2})
+		a.UnionWith(New([]int{2, 3}), New([]int{4}))
+		expected := []int{1, 2, 3, 4}
+		if !reflect.DeepEqual(sortedSlice(a), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(a))
+		}
+	})
+
+	t.Run("intersect with", func(t *testing.T) {
+		a := New([]int{1, 2, 3, 4})
+		a.IntersectWith(New([]int{2, 3, 4, 5}), New([]int{3, 4}))
+		expected := []int{3, 4}
+		if !reflect.DeepEqual(sortedSlice(a), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(a))
+		}
+	})
+
+	t.Run("except with smaller and larger operands", func(t *testing.T) {
+		a := New([]int{1, 2, 3, 4, 5})
+		a.ExceptWith(New([]int{1}), New([]int{5, 6, 7, 8, 9, 10, 11}))
+		expected := []int{2, 3, 4}
+		if !reflect.DeepEqual(sortedSlice(a), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(a))
+		}
+	})
+
+	t.Run("except with itself", func(t *testing.T) {
+		a := New([]int{1, 2, 3})
+		a.ExceptWith(a)
+		if !a.IsEmpty() {
+			t.Errorf("Expected empty set, got %v", a.ToSlice())
+		}
+	})
+}
+
+func TestFromSeq(t *testing.T) {
+	t.Run("collect from sequence", func(t *testing.T) {
+		set := FromSeq(slices.Values([]int{3, 1, 2, 3, 1}))
+		expected := []int{1, 2, 3}
+		if !reflect.DeepEqual(sortedSlice(set), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(set))
+		}
+	})
+
+	t.Run("collect from empty sequence", func(t *testing.T) {
+		set := FromSeq(slices.Values([]string(nil)))
+		if set == nil || !set.IsEmpty() {
+			t.Error("Expected non-nil empty set")
+		}
+	})
+}
+
+func TestAll(t *testing.T) {
+	t.Run("iterate all elements", func(t *testing.T) {
+		set := New([]int{1, 2, 3, 4})
+		got := slices.Sorted(set.All())
+		expected := []int{1, 2, 3, 4}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("early break", func(t *testing.T) {
+		set := New([]int{1, 2, 3, 4})
+		count := 0
+		for range set.All() {
+			count++
+			if count == 2 {
+				break
+			}
+		}
+		if count != 2 {
+			t.Errorf("Expected iteration to stop after 2 elements, got %d", count)
+		}
+	})
+
+	t.Run("remove during iteration", func(t *testing.T) {
+		set := New([]int{1, 2, 3, 4})
+		for v := range set.All() {
+			if v%2 == 0 {
+				set.Remove(v)
+			}
+		}
+		expected := []int{1, 3}
+		if !reflect.DeepEqual(sortedSlice(set), expected) {
+			t.Errorf("Expected %v, got %v", expected, sortedSlice(set))
+		}
+	})
+}
+
 // Benchmark tests
 func BenchmarkHashSetAdd(b *testing.B) {
 	set := New[int](nil)
@@ -591,3 +862,16 @@ func BenchmarkHashSetToSlice(b *testing.B) {
 		set.ToSlice()
 	}
 }
+
+func BenchmarkHashSetIntersection(b *testing.B) {
+	large := New[int](nil)
+	for i := 0; i < 100000; i++ {
+		large.Add(i)
+	}
+	small := New([]int{1, 500, 99999})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		large.Intersection(small)
+	}
+}
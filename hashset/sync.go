@@ -0,0 +1,280 @@
+package hashset
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncHashSet is a HashSet that is safe for concurrent use by multiple goroutines.
+// Reads take a shared lock and writes take an exclusive lock on a sync.RWMutex,
+// and compound operations such as AddIfAbsent are performed atomically.
+// HashSet arguments passed to its methods must not be modified concurrently.
+//
+// Type parameters:
+//   - T: the element type, must be comparable
+type SyncHashSet[T comparable] struct {
+	mu  sync.RWMutex // guards set
+	set HashSet[T]   // the wrapped set
+}
+
+// NewSync creates and returns a new SyncHashSet wrapping the given set.
+// The caller must not use set directly afterwards. If set is nil, an empty set is used.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - set: the HashSet to guard, can be nil
+//
+// Returns:
+//   - a new SyncHashSet guarding set
+//
+// Example:
+//
+//	set := NewSync(New([]int{1, 2, 3}))
+//	go set.Add(4)
+func NewSync[T comparable](set HashSet[T]) *SyncHashSet[T] {
+	if set == nil {
+		set = make(HashSet[T])
+	}
+	return &SyncHashSet[T]{set: set}
+}
+
+// Add inserts an element into the set.
+// Time complexity: O(1) average case.
+func (s *SyncHashSet[T]) Add(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Add(value)
+}
+
+// AddIfAbsent inserts an element only if it is not already in the set.
+// The check and the insertion happen atomically.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - value: the element to add to the set
+//
+// Returns:
+//   - true if the element was added, false if it was already present
+//
+// Example:
+//
+//	if set.AddIfAbsent(requestID) {
+//	    process(requestID)  // Only the first caller processes the request
+//	}
+func (s *SyncHashSet[T]) AddIfAbsent(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.set.Contains(value) {
+		return false
+	}
+	s.set.Add(value)
+	return true
+}
+
+// Remove deletes an element from the set.
+// Time complexity: O(1) average case.
+func (s *SyncHashSet[T]) Remove(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Remove(value)
+}
+
+// RemoveIfPresent deletes an element and reports whether it was in the set.
+// The check and the removal happen atomically.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - value: the element to remove from the set
+//
+// Returns:
+//   - true if the element was removed, false if it was not present
+func (s *SyncHashSet[T]) RemoveIfPresent(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.set.Contains(value) {
+		return false
+	}
+	s.set.Remove(value)
+	return true
+}
+
+// Contains checks if an element exists in the set.
+// Time complexity: O(1) average case.
+func (s *SyncHashSet[T]) Contains(value T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Contains(value)
+}
+
+// Size returns the number of elements in the set.
+// Time complexity: O(1).
+func (s *SyncHashSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Size()
+}
+
+// IsEmpty returns true if the set contains no elements.
+// Time complexity: O(1).
+func (s *SyncHashSet[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsEmpty()
+}
+
+// Clear removes all elements from the set, making it empty.
+// Time complexity: O(n) where n is the number of elements.
+func (s *SyncHashSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Clear()
+}
+
+// ToSlice returns a slice containing all elements in the set.
+// Time complexity: O(n) where n is the number of elements.
+func (s *SyncHashSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.ToSlice()
+}
+
+// All returns an iterator over a snapshot of the elements of the set.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the set.
+// Time complexity: O(n) time and O(n) space.
+func (s *SyncHashSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.ToSlice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Clone returns a plain HashSet copy of the set's current contents.
+// Time complexity: O(n) where n is the number of elements.
+func (s *SyncHashSet[T]) Clone() HashSet[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Clone()
+}
+
+// Equals checks if the set contains exactly the same elements as other.
+// Time complexity: O(n) where n is the number of elements.
+func (s *SyncHashSet[T]) Equals(other HashSet[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Equals(other)
+}
+
+// Union returns a new HashSet containing every element in the set or in any of the others.
+// Time complexity: O(n + m) where m is the combined size of the others.
+func (s *SyncHashSet[T]) Union(others ...HashSet[T]) HashSet[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Union(others...)
+}
+
+// Intersection returns a new HashSet containing the elements present in the set and all of the others.
+// Time complexity: O(k * m) where k is the size of the smallest set and m is the number of sets.
+func (s *SyncHashSet[T]) Intersection(others ...HashSet[T]) HashSet[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Intersection(others...)
+}
+
+// Difference returns a new HashSet containing the elements of the set that are not in any of the others.
+// Time complexity: O(n + m) where m is the combined size of the others.
+func (s *SyncHashSet[T]) Difference(others ...HashSet[T]) HashSet[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Difference(others...)
+}
+
+// SymmetricDifference returns a new HashSet containing the elements in exactly one of the set and other.
+// Time complexity: O(n + m) where m is the size of other.
+func (s *SyncHashSet[T]) SymmetricDifference(other HashSet[T]) HashSet[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.SymmetricDifference(other)
+}
+
+// IsSubsetOf checks if every element of the set is also contained in other.
+// Time complexity: O(n) where n is the number of elements.
+func (s *SyncHashSet[T]) IsSubsetOf(other HashSet[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsSubsetOf(other)
+}
+
+// IsSupersetOf checks if the set contains every element of other.
+// Time complexity: O(m) where m is the size of other.
+func (s *SyncHashSet[T]) IsSupersetOf(other HashSet[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsSupersetOf(other)
+}
+
+// IsDisjoint checks if the set and other have no elements in common.
+// Time complexity: O(min(n, m)) where m is the size of other.
+func (s *SyncHashSet[T]) IsDisjoint(other HashSet[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsDisjoint(other)
+}
+
+// UnionWith adds every element of the given sets to the set in place.
+// Time complexity: O(m) where m is the combined size of the others.
+func (s *SyncHashSet[T]) UnionWith(others ...HashSet[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.UnionWith(others...)
+}
+
+// IntersectWith removes every element that is not present in all of the given sets.
+// Time complexity: O(n * m) where m is the number of sets.
+func (s *SyncHashSet[T]) IntersectWith(others ...HashSet[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.IntersectWith(others...)
+}
+
+// ExceptWith removes every element that is present in any of the given sets.
+// Time complexity: O(sum of min(n, m_i)) where m_i is the size of each other.
+func (s *SyncHashSet[T]) ExceptWith(others ...HashSet[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.ExceptWith(others...)
+}
+
+// MarshalJSON implements json.Marshaler using the encoding of the wrapped set.
+// Time complexity: O(n).
+func (s *SyncHashSet[T]) MarshalJSON() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of the wrapped set.
+// Time complexity: O(n).
+func (s *SyncHashSet[T]) UnmarshalJSON(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.set.UnmarshalJSON(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using the encoding of the wrapped set.
+// Time complexity: O(n).
+func (s *SyncHashSet[T]) MarshalBinary() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the wrapped set.
+// Time complexity: O(n).
+func (s *SyncHashSet[T]) UnmarshalBinary(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.set.UnmarshalBinary(data)
+}
@@ -0,0 +1,143 @@
+package hashset
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestNewSync(t *testing.T) {
+	t.Run("wrap nil set", func(t *testing.T) {
+		set := NewSync[int](nil)
+		if !set.IsEmpty() {
+			t.Error("Expected empty set")
+		}
+		set.Add(1)
+		if !set.Contains(1) {
+			t.Error("Expected set to contain 1")
+		}
+	})
+
+	t.Run("wrap existing set", func(t *testing.T) {
+		set := NewSync(New([]int{1, 2, 3}))
+		if set.Size() != 3 {
+			t.Errorf("Expected size 3, got %d", set.Size())
+		}
+		if !set.Equals(New([]int{3, 2, 1})) {
+			t.Error("Expected wrapped set to equal its contents")
+		}
+	})
+}
+
+func TestSyncHashSetOperations(t *testing.T) {
+	set := NewSync(New([]int{1, 2, 3}))
+
+	if set.AddIfAbsent(2) {
+		t.Error("Expected AddIfAbsent to fail for existing element")
+	}
+	if !set.AddIfAbsent(4) {
+		t.Error("Expected AddIfAbsent to succeed for new element")
+	}
+	if !set.RemoveIfPresent(1) {
+		t.Error("Expected RemoveIfPresent to succeed for existing element")
+	}
+	if set.RemoveIfPresent(1) {
+		t.Error("Expected RemoveIfPresent to fail for missing element")
+	}
+
+	expected := []int{2, 3, 4}
+	if got := slices.Sorted(set.All()); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	other := New([]int{3, 4, 5})
+	if got := sortedSlice(set.Union(other)); !reflect.DeepEqual(got, []int{2, 3, 4, 5}) {
+		t.Errorf("Unexpected union %v", got)
+	}
+	if got := sortedSlice(set.Intersection(other)); !reflect.DeepEqual(got, []int{3, 4}) {
+		t.Errorf("Unexpected intersection %v", got)
+	}
+	if got := sortedSlice(set.Difference(other)); !reflect.DeepEqual(got, []int{2}) {
+		t.Errorf("Unexpected difference %v", got)
+	}
+	if got := sortedSlice(set.SymmetricDifference(other)); !reflect.DeepEqual(got, []int{2, 5}) {
+		t.Errorf("Unexpected symmetric difference %v", got)
+	}
+	if set.IsSubsetOf(other) || set.IsSupersetOf(other) || set.IsDisjoint(other) {
+		t.Error("Unexpected subset relationship")
+	}
+
+	set.UnionWith(New([]int{9}))
+	set.IntersectWith(New([]int{2, 9}))
+	set.ExceptWith(New([]int{9}))
+	if got := sortedSlice(set.Clone()); !reflect.DeepEqual(got, []int{2}) {
+		t.Errorf("Expected [2] after in-place operations, got %v", got)
+	}
+
+	for v := range set.All() {
+		set.Remove(v) // modifying during iteration must not deadlock
+	}
+	if !set.IsEmpty() {
+		t.Error("Expected set to be empty")
+	}
+	set.Add(1)
+	set.Clear()
+	if set.Size() != 0 || len(set.ToSlice()) != 0 {
+		t.Error("Expected set to be empty after Clear")
+	}
+}
+
+func TestSyncHashSetConcurrent(t *testing.T) {
+	const goroutines = 32
+	const perGoroutine = 500
+
+	set := NewSync[int](nil)
+	var added atomic.Int64
+	var wg sync.WaitGroup
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				// every goroutine competes for the same keys
+				if set.AddIfAbsent(i) {
+					added.Add(1)
+				}
+				set.Contains(i)
+				set.Size()
+				if g%4 == 0 {
+					set.ToSlice()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if added.Load() != perGoroutine {
+		t.Errorf("Expected exactly %d successful AddIfAbsent calls, got %d", perGoroutine, added.Load())
+	}
+	if set.Size() != perGoroutine {
+		t.Errorf("Expected size %d, got %d", perGoroutine, set.Size())
+	}
+
+	var removed atomic.Int64
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				if set.RemoveIfPresent(i) {
+					removed.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if removed.Load() != perGoroutine || !set.IsEmpty() {
+		t.Errorf("Expected %d removals and empty set, got %d removals and size %d", perGoroutine, removed.Load(), set.Size())
+	}
+}
@@ -0,0 +1,62 @@
+// Package ring holds the circular-buffer arithmetic shared by queue.RingQueue and deque.Deque.
+// Buffers are slices whose length is zero or a power of two, holding size elements that start
+// at index head and wrap around the end of the slice.
+package ring
+
+// MinCapacity is the smallest buffer a ring allocates and the size it never shrinks below.
+const MinCapacity = 8
+
+// CapacityFor returns the smallest power of two that can hold n elements, but no less than MinCapacity.
+// Time complexity: O(log n).
+//
+// Parameters:
+//   - n: the number of elements the buffer must hold
+//
+// Returns:
+//   - the buffer capacity to allocate
+func CapacityFor(n int) int {
+	capacity := MinCapacity
+	for capacity < n {
+		capacity *= 2
+	}
+	return capacity
+}
+
+// CopyTo copies the size elements of buf that start at head into dst in order, unwrapping them.
+// dst must hold at least size elements.
+// Time complexity: O(size).
+//
+// Parameters:
+//   - dst: the destination slice
+//   - buf: the circular buffer
+//   - head: index of the first element in buf
+//   - size: number of elements to copy
+func CopyTo[T any](dst, buf []T, head, size int) {
+	if size == 0 {
+		return
+	}
+	if head+size <= len(buf) {
+		copy(dst, buf[head:head+size])
+		return
+	}
+	n := copy(dst, buf[head:])
+	copy(dst[n:], buf[:size-n])
+}
+
+// Resize returns a new buffer of the given capacity holding the size elements of buf that start
+// at head, with the first element moved to index 0.
+// Time complexity: O(capacity).
+//
+// Parameters:
+//   - buf: the circular buffer
+//   - head: index of the first element in buf
+//   - size: number of elements in buf
+//   - capacity: the length of the new buffer, a power of two of at least size
+//
+// Returns:
+//   - the new buffer, whose head is 0
+func Resize[T any](buf []T, head, size, capacity int) []T {
+	resized := make([]T, capacity)
+	CopyTo(resized, buf, head, size)
+	return resized
+}
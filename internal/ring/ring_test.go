@@ -0,0 +1,58 @@
+package ring
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCapacityFor(t *testing.T) {
+	tests := []struct {
+		n, expected int
+	}{
+		{0, MinCapacity},
+		{1, MinCapacity},
+		{8, 8},
+		{9, 16},
+		{100, 128},
+	}
+	for _, tc := range tests {
+		if got := CapacityFor(tc.n); got != tc.expected {
+			t.Errorf("Expected CapacityFor(%d) = %d, got %d", tc.n, tc.expected, got)
+		}
+	}
+}
+
+func TestCopyTo(t *testing.T) {
+	buf := []int{4, 5, 0, 0, 0, 1, 2, 3}
+
+	t.Run("wrapped", func(t *testing.T) {
+		dst := make([]int, 5)
+		CopyTo(dst, buf, 5, 5)
+		if !reflect.DeepEqual(dst, []int{1, 2, 3, 4, 5}) {
+			t.Errorf("Expected [1 2 3 4 5], got %v", dst)
+		}
+	})
+
+	t.Run("contiguous", func(t *testing.T) {
+		dst := make([]int, 2)
+		CopyTo(dst, buf, 5, 2)
+		if !reflect.DeepEqual(dst, []int{1, 2}) {
+			t.Errorf("Expected [1 2], got %v", dst)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		CopyTo([]int{}, nil, 0, 0)
+	})
+}
+
+func TestResize(t *testing.T) {
+	buf := []int{4, 5, 0, 0, 0, 1, 2, 3}
+	resized := Resize(buf, 5, 5, 16)
+	if len(resized) != 16 {
+		t.Errorf("Expected length 16, got %d", len(resized))
+	}
+	if !reflect.DeepEqual(resized[:5], []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected [1 2 3 4 5] at the front, got %v", resized[:5])
+	}
+}
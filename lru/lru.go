@@ -0,0 +1,344 @@
+// Package lru provides a generic least-recently-used cache built on orderedhashmap.OrderedHashMap.
+// Entries are kept in recency order, least recently used first, so eviction and refresh are O(1).
+package lru
+
+import (
+	"iter"
+
+	"github.com/thefrost13/gollections/orderedhashmap"
+)
+
+// Cache is a generic fixed-capacity cache that evicts the least recently used entry when full.
+// Get and Set mark an entry as most recently used, while Peek and Contains leave recency untouched.
+// The cache records hit, miss and eviction counts that can be read with Stats.
+// A Cache must be created with New or NewWithEvict.
+//
+// Type parameters:
+//   - K: the key type, must be comparable
+//   - V: the value type, can be any type
+type Cache[K comparable, V any] struct {
+	items    *orderedhashmap.OrderedHashMap[K, V] // entries ordered from least to most recently used
+	capacity int                                  // maximum number of entries, 0 means unbounded
+	onEvict  func(key K, value V)                 // called for each entry evicted to make room, can be nil
+	stats    Stats                                // hit, miss and eviction counters
+}
+
+// Stats holds the access counters of a Cache.
+type Stats struct {
+	Hits      uint64 // number of Get calls that found their key
+	Misses    uint64 // number of Get calls that did not find their key
+	Evictions uint64 // number of entries removed to stay within capacity
+}
+
+// HitRatio returns the fraction of Get calls that were hits, or 0 if there were none.
+//
+// Returns:
+//   - Hits / (Hits + Misses), in the range [0, 1]
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// New creates and returns a new empty Cache holding at most capacity entries.
+// A capacity of zero or less creates an unbounded cache that never evicts.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - capacity: the maximum number of entries, <= 0 for unbounded
+//
+// Returns:
+//   - a new empty Cache
+//
+// Example:
+//
+//	cache := New[string, []byte](1024)
+//	cache.Set("index.html", page)
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return NewWithEvict[K, V](capacity, nil)
+}
+
+// NewWithEvict creates and returns a new empty Cache that calls onEvict for every entry
+// removed to stay within capacity, including entries dropped by Resize.
+// Entries removed explicitly with Delete or Clear do not trigger the callback.
+// The callback runs synchronously and must not use the cache.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - capacity: the maximum number of entries, <= 0 for unbounded
+//   - onEvict: function called with each evicted key and value, can be nil
+//
+// Returns:
+//   - a new empty Cache
+//
+// Example:
+//
+//	cache := NewWithEvict(100, func(key string, conn *Conn) {
+//	    conn.Close()
+//	})
+func NewWithEvict[K comparable, V any](capacity int, onEvict func(key K, value V)) *Cache[K, V] {
+	return &Cache[K, V]{
+		items:    orderedhashmap.New[K, V](),
+		capacity: max(capacity, 0),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value stored for key and marks the entry as most recently used.
+// It counts as a hit or a miss in Stats.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - value: the value associated with the key, or zero value if key not found
+//   - ok: true if the key was found, false otherwise
+//
+// Example:
+//
+//	if page, ok := cache.Get("index.html"); ok {
+//	    serve(page)
+//	}
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	value, ok := c.items.Get(key)
+	if !ok {
+		c.stats.Misses++
+		return value, false
+	}
+	c.stats.Hits++
+	c.items.MoveToBack(key)
+	return value, true
+}
+
+// Peek returns the value stored for key without changing its recency or the statistics.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - value: the value associated with the key, or zero value if key not found
+//   - ok: true if the key was found, false otherwise
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	return c.items.Get(key)
+}
+
+// Contains reports whether key is in the cache without changing its recency or the statistics.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - true if the key is in the cache, false otherwise
+func (c *Cache[K, V]) Contains(key K) bool {
+	_, ok := c.items.Get(key)
+	return ok
+}
+
+// Set stores value for key and marks the entry as most recently used.
+// If the cache is full and key is new, the least recently used entry is evicted first.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to insert or update
+//   - value: the value to associate with the key
+//
+// Returns:
+//   - true if an entry was evicted to make room, false otherwise
+//
+// Example:
+//
+//	if cache.Set("user:42", user) {
+//	    fmt.Println("evicted an older entry")
+//	}
+func (c *Cache[K, V]) Set(key K, value V) bool {
+	if c.items.MoveToBack(key) {
+		c.items.Set(key, value)
+		return false
+	}
+	evicted := false
+	if c.capacity > 0 && c.items.Size() >= c.capacity {
+		c.evictOldest()
+		evicted = true
+	}
+	c.items.Set(key, value)
+	return evicted
+}
+
+// Delete removes the entry for key. It does not call the eviction callback.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to remove
+//
+// Returns:
+//   - true if the key was present, false otherwise
+func (c *Cache[K, V]) Delete(key K) bool {
+	if !c.Contains(key) {
+		return false
+	}
+	c.items.Delete(key)
+	return true
+}
+
+// Oldest returns the least recently used entry, which is the next to be evicted,
+// without changing its recency.
+// Time complexity: O(1).
+//
+// Returns:
+//   - key: the least recently used key, or zero value if the cache is empty
+//   - value: its value, or zero value if the cache is empty
+//   - ok: true if the cache is not empty, false otherwise
+func (c *Cache[K, V]) Oldest() (K, V, bool) {
+	return c.items.First()
+}
+
+// RemoveOldest evicts the least recently used entry, calling the eviction callback.
+// Time complexity: O(1).
+//
+// Returns:
+//   - key: the evicted key, or zero value if the cache is empty
+//   - value: its value, or zero value if the cache is empty
+//   - ok: true if an entry was evicted, false if the cache was empty
+func (c *Cache[K, V]) RemoveOldest() (K, V, bool) {
+	key, value, ok := c.items.First()
+	if ok {
+		c.evictOldest()
+	}
+	return key, value, ok
+}
+
+// Resize changes the capacity of the cache, evicting least recently used entries
+// if it now holds more than the new capacity. A capacity <= 0 makes the cache unbounded.
+// Time complexity: O(k) where k is the number of evicted entries.
+//
+// Parameters:
+//   - capacity: the new maximum number of entries, <= 0 for unbounded
+//
+// Returns:
+//   - the number of entries evicted
+func (c *Cache[K, V]) Resize(capacity int) int {
+	c.capacity = max(capacity, 0)
+	evicted := 0
+	for c.capacity > 0 && c.items.Size() > c.capacity {
+		c.evictOldest()
+		evicted++
+	}
+	return evicted
+}
+
+// Capacity returns the maximum number of entries, or 0 if the cache is unbounded.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the capacity of the cache
+func (c *Cache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Size returns the number of entries in the cache.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the number of entries
+func (c *Cache[K, V]) Size() int {
+	return c.items.Size()
+}
+
+// IsEmpty returns true if the cache contains no entries.
+// Time complexity: O(1).
+//
+// Returns:
+//   - true if the cache is empty, false otherwise
+func (c *Cache[K, V]) IsEmpty() bool {
+	return c.items.IsEmpty()
+}
+
+// Stats returns a copy of the hit, miss and eviction counters.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the current statistics
+//
+// Example:
+//
+//	s := cache.Stats()
+//	fmt.Printf("hit ratio %.2f, %d evictions\n", s.HitRatio(), s.Evictions)
+func (c *Cache[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// ResetStats sets all statistics counters back to zero.
+// Time complexity: O(1).
+func (c *Cache[K, V]) ResetStats() {
+	c.stats = Stats{}
+}
+
+// Keys returns the keys in the cache from least to most recently used.
+// Time complexity: O(n) where n is the number of entries.
+//
+// Returns:
+//   - a slice of keys, least recently used first
+func (c *Cache[K, V]) Keys() []K {
+	return c.items.Keys()
+}
+
+// Values returns the values in the cache from least to most recently used.
+// Time complexity: O(n) where n is the number of entries.
+//
+// Returns:
+//   - a slice of values, least recently used first
+func (c *Cache[K, V]) Values() []V {
+	return c.items.Values()
+}
+
+// ToSlice returns the entries in the cache from least to most recently used.
+// Time complexity: O(n) where n is the number of entries.
+//
+// Returns:
+//   - a slice of key-value pairs, least recently used first
+func (c *Cache[K, V]) ToSlice() []orderedhashmap.KVPair[K, V] {
+	return c.items.ToSlice()
+}
+
+// All returns an iterator over the entries from least to most recently used.
+// Iterating does not change recency. The cache must not be modified during iteration.
+// Time complexity: O(n) for a full iteration.
+//
+// Returns:
+//   - an iter.Seq2 yielding each key and its value, least recently used first
+func (c *Cache[K, V]) All() iter.Seq2[K, V] {
+	return c.items.All()
+}
+
+// Backward returns an iterator over the entries from most to least recently used.
+// Iterating does not change recency. The cache must not be modified during iteration.
+// Time complexity: O(n) for a full iteration.
+//
+// Returns:
+//   - an iter.Seq2 yielding each key and its value, most recently used first
+func (c *Cache[K, V]) Backward() iter.Seq2[K, V] {
+	return c.items.Backward()
+}
+
+// Clear removes all entries without calling the eviction callback.
+// Statistics are kept; use ResetStats to clear them.
+// Time complexity: O(1).
+func (c *Cache[K, V]) Clear() {
+	c.items = orderedhashmap.New[K, V]()
+}
+
+// evictOldest removes the least recently used entry, counts it and calls the eviction callback.
+// The cache must not be empty.
+func (c *Cache[K, V]) evictOldest() {
+	key, value, _ := c.items.First()
+	c.items.Delete(key)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(key, value)
+	}
+}
@@ -0,0 +1,269 @@
+package lru
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+type evicted struct {
+	key   string
+	value int
+}
+
+func TestNew(t *testing.T) {
+	t.Run("New creates empty cache", func(t *testing.T) {
+		c := New[string, int](3)
+		if c == nil {
+			t.Fatal("Expected cache to be initialized, got nil")
+		}
+		if !c.IsEmpty() || c.Size() != 0 {
+			t.Errorf("Expected empty cache, got size %d", c.Size())
+		}
+		if c.Capacity() != 3 {
+			t.Errorf("Expected capacity 3, got %d", c.Capacity())
+		}
+	})
+
+	t.Run("Non-positive capacity is unbounded", func(t *testing.T) {
+		c := New[int, int](-5)
+		if c.Capacity() != 0 {
+			t.Errorf("Expected capacity 0, got %d", c.Capacity())
+		}
+		for i := 0; i < 1000; i++ {
+			if c.Set(i, i) {
+				t.Fatal("Expected unbounded cache never to evict")
+			}
+		}
+		if c.Size() != 1000 {
+			t.Errorf("Expected size 1000, got %d", c.Size())
+		}
+	})
+}
+
+func TestCacheEviction(t *testing.T) {
+	t.Run("Evicts least recently set", func(t *testing.T) {
+		c := New[string, int](2)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		if !c.Set("c", 3) {
+			t.Error("Expected Set to report an eviction")
+		}
+		if c.Contains("a") {
+			t.Error("Expected a to be evicted")
+		}
+		if !reflect.DeepEqual(c.Keys(), []string{"b", "c"}) {
+			t.Errorf("Expected keys [b c], got %v", c.Keys())
+		}
+	})
+
+	t.Run("Get refreshes recency", func(t *testing.T) {
+		c := New[string, int](2)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		c.Get("a")
+		c.Set("c", 3)
+		if !c.Contains("a") || c.Contains("b") {
+			t.Errorf("Expected b to be evicted, got keys %v", c.Keys())
+		}
+	})
+
+	t.Run("Peek and Contains do not refresh recency", func(t *testing.T) {
+		c := New[string, int](2)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		if v, ok := c.Peek("a"); !ok || v != 1 {
+			t.Errorf("Expected (1, true), got (%d, %v)", v, ok)
+		}
+		c.Contains("a")
+		c.Set("c", 3)
+		if c.Contains("a") {
+			t.Error("Expected a to be evicted despite Peek")
+		}
+	})
+
+	t.Run("Updating an existing key refreshes it without eviction", func(t *testing.T) {
+		c := New[string, int](2)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		if c.Set("a", 10) {
+			t.Error("Expected no eviction when updating an existing key")
+		}
+		c.Set("c", 3)
+		if v, ok := c.Peek("a"); !ok || v != 10 {
+			t.Errorf("Expected (10, true), got (%d, %v)", v, ok)
+		}
+		if c.Contains("b") {
+			t.Error("Expected b to be evicted")
+		}
+	})
+
+	t.Run("Eviction callback", func(t *testing.T) {
+		var got []evicted
+		c := NewWithEvict(2, func(key string, value int) {
+			got = append(got, evicted{key, value})
+		})
+		c.Set("a", 1)
+		c.Set("b", 2)
+		c.Set("c", 3)
+		c.Delete("b") // explicit removal is not an eviction
+		c.Set("d", 4)
+		c.Set("e", 5)
+		expected := []evicted{{"a", 1}, {"c", 3}}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected evictions %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("Oldest and RemoveOldest", func(t *testing.T) {
+		var got []evicted
+		c := NewWithEvict(3, func(key string, value int) {
+			got = append(got, evicted{key, value})
+		})
+		if _, _, ok := c.Oldest(); ok {
+			t.Error("Expected Oldest to report false on empty cache")
+		}
+		if _, _, ok := c.RemoveOldest(); ok {
+			t.Error("Expected RemoveOldest to report false on empty cache")
+		}
+		c.Set("a", 1)
+		c.Set("b", 2)
+		c.Get("a")
+		if k, v, ok := c.Oldest(); !ok || k != "b" || v != 2 {
+			t.Errorf("Expected (b, 2, true), got (%s, %d, %v)", k, v, ok)
+		}
+		if k, _, ok := c.RemoveOldest(); !ok || k != "b" {
+			t.Errorf("Expected to remove b, got (%s, %v)", k, ok)
+		}
+		if !reflect.DeepEqual(got, []evicted{{"b", 2}}) {
+			t.Errorf("Expected callback for b, got %v", got)
+		}
+		if c.Stats().Evictions != 1 {
+			t.Errorf("Expected 1 eviction, got %d", c.Stats().Evictions)
+		}
+	})
+
+	t.Run("Resize", func(t *testing.T) {
+		var got []string
+		c := NewWithEvict(5, func(key string, _ int) {
+			got = append(got, key)
+		})
+		for i := 0; i < 5; i++ {
+			c.Set(strconv.Itoa(i), i)
+		}
+		if n := c.Resize(2); n != 3 {
+			t.Errorf("Expected 3 evictions, got %d", n)
+		}
+		if !reflect.DeepEqual(got, []string{"0", "1", "2"}) {
+			t.Errorf("Expected evicted keys [0 1 2], got %v", got)
+		}
+		if n := c.Resize(0); n != 0 || c.Capacity() != 0 {
+			t.Errorf("Expected unbounded resize without evictions, got %d and capacity %d", n, c.Capacity())
+		}
+	})
+}
+
+func TestCacheStats(t *testing.T) {
+	c := New[string, int](2)
+	if c.Stats().HitRatio() != 0 {
+		t.Errorf("Expected hit ratio 0, got %f", c.Stats().HitRatio())
+	}
+
+	c.Set("a", 1)
+	c.Get("a")
+	c.Get("a")
+	c.Get("a")
+	c.Get("missing")
+	c.Peek("missing") // Peek is not counted
+	c.Set("b", 2)
+	c.Set("c", 3)
+
+	expected := Stats{Hits: 3, Misses: 1, Evictions: 1}
+	if c.Stats() != expected {
+		t.Errorf("Expected %+v, got %+v", expected, c.Stats())
+	}
+	if c.Stats().HitRatio() != 0.75 {
+		t.Errorf("Expected hit ratio 0.75, got %f", c.Stats().HitRatio())
+	}
+
+	c.ResetStats()
+	if c.Stats() != (Stats{}) {
+		t.Errorf("Expected zero stats, got %+v", c.Stats())
+	}
+}
+
+func TestCacheIteration(t *testing.T) {
+	c := New[string, int](4)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+	c.Get("a")
+
+	if !reflect.DeepEqual(c.Keys(), []string{"b", "c", "a"}) {
+		t.Errorf("Expected keys [b c a], got %v", c.Keys())
+	}
+	if !reflect.DeepEqual(c.Values(), []int{2, 3, 1}) {
+		t.Errorf("Expected values [2 3 1], got %v", c.Values())
+	}
+	if len(c.ToSlice()) != 3 || c.ToSlice()[0].Key != "b" {
+		t.Errorf("Unexpected ToSlice %v", c.ToSlice())
+	}
+
+	var forward, backward []string
+	for k := range c.All() {
+		forward = append(forward, k)
+	}
+	for k := range c.Backward() {
+		backward = append(backward, k)
+	}
+	slices.Reverse(backward)
+	if !reflect.DeepEqual(forward, c.Keys()) || !reflect.DeepEqual(backward, c.Keys()) {
+		t.Errorf("Expected iterators to match keys, got %v and %v", forward, backward)
+	}
+	if c.Stats().Hits != 1 {
+		t.Errorf("Expected iteration not to count hits, got %d", c.Stats().Hits)
+	}
+}
+
+func TestCacheDeleteAndClear(t *testing.T) {
+	c := New[string, int](3)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	if !c.Delete("a") {
+		t.Error("Expected Delete to report present key")
+	}
+	if c.Delete("a") {
+		t.Error("Expected Delete to report missing key")
+	}
+	c.Clear()
+	if !c.IsEmpty() {
+		t.Error("Expected cache to be empty after Clear")
+	}
+	c.Set("c", 3)
+	if v, ok := c.Get("c"); !ok || v != 3 {
+		t.Errorf("Expected (3, true), got (%d, %v)", v, ok)
+	}
+}
+
+// Benchmark tests
+func BenchmarkCacheSet(b *testing.B) {
+	c := New[int, int](1024)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		c.Set(i, i)
+	}
+}
+
+func BenchmarkCacheGetHit(b *testing.B) {
+	c := New[int, int](1024)
+	for i := 0; i < 1024; i++ {
+		c.Set(i, i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		c.Get(i & 1023)
+	}
+}
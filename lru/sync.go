@@ -0,0 +1,229 @@
+package lru
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/thefrost13/gollections/orderedhashmap"
+)
+
+// SyncCache is a Cache that is safe for concurrent use by multiple goroutines.
+// Because Get updates recency and statistics, every operation takes an exclusive lock
+// on a sync.Mutex, and compound operations such as GetOrSet are performed atomically.
+// The eviction callback runs while the lock is held and must not use the cache.
+//
+// Type parameters:
+//   - K: the key type, must be comparable
+//   - V: the value type, can be any type
+type SyncCache[K comparable, V any] struct {
+	mu    sync.Mutex   // guards cache
+	cache *Cache[K, V] // the wrapped cache
+}
+
+// NewSync creates and returns a new SyncCache wrapping the given cache.
+// The caller must not use cache directly afterwards. The cache must not be nil.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - cache: the Cache to guard
+//
+// Returns:
+//   - a new SyncCache guarding cache
+//
+// Example:
+//
+//	c := NewSync(New[string, int](128))
+//	go c.Set("hits", 1)
+func NewSync[K comparable, V any](cache *Cache[K, V]) *SyncCache[K, V] {
+	return &SyncCache[K, V]{cache: cache}
+}
+
+// Get returns the value stored for key and marks the entry as most recently used.
+// Time complexity: O(1) average case.
+func (c *SyncCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Get(key)
+}
+
+// Peek returns the value stored for key without changing its recency or the statistics.
+// Time complexity: O(1) average case.
+func (c *SyncCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Peek(key)
+}
+
+// Contains reports whether key is in the cache without changing its recency or the statistics.
+// Time complexity: O(1) average case.
+func (c *SyncCache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Contains(key)
+}
+
+// Set stores value for key and marks the entry as most recently used,
+// reporting whether an entry was evicted to make room.
+// Time complexity: O(1) average case.
+func (c *SyncCache[K, V]) Set(key K, value V) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Set(key, value)
+}
+
+// GetOrSet returns the existing value for key if present, counting a hit and refreshing its recency.
+// Otherwise it counts a miss, stores value and returns it.
+// The lookup and the insertion happen atomically.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to look up or insert
+//   - value: the value to store if key is absent
+//
+// Returns:
+//   - actual: the existing value if key was present, otherwise value
+//   - loaded: true if the value was already present, false if it was stored
+//
+// Example:
+//
+//	conn, loaded := c.GetOrSet(addr, dial(addr))
+func (c *SyncCache[K, V]) GetOrSet(key K, value V) (actual V, loaded bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, ok := c.cache.Get(key); ok {
+		return existing, true
+	}
+	c.cache.Set(key, value)
+	return value, false
+}
+
+// Delete removes the entry for key, reporting whether it was present.
+// Time complexity: O(1) average case.
+func (c *SyncCache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Delete(key)
+}
+
+// Oldest returns the least recently used entry without changing its recency.
+// Time complexity: O(1).
+func (c *SyncCache[K, V]) Oldest() (K, V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Oldest()
+}
+
+// RemoveOldest evicts the least recently used entry, calling the eviction callback.
+// Time complexity: O(1).
+func (c *SyncCache[K, V]) RemoveOldest() (K, V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.RemoveOldest()
+}
+
+// Resize changes the capacity of the cache and returns the number of entries evicted.
+// Time complexity: O(k) where k is the number of evicted entries.
+func (c *SyncCache[K, V]) Resize(capacity int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Resize(capacity)
+}
+
+// Capacity returns the maximum number of entries, or 0 if the cache is unbounded.
+// Time complexity: O(1).
+func (c *SyncCache[K, V]) Capacity() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Capacity()
+}
+
+// Size returns the number of entries in the cache.
+// Time complexity: O(1).
+func (c *SyncCache[K, V]) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Size()
+}
+
+// IsEmpty returns true if the cache contains no entries.
+// Time complexity: O(1).
+func (c *SyncCache[K, V]) IsEmpty() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.IsEmpty()
+}
+
+// Stats returns a copy of the hit, miss and eviction counters.
+// Time complexity: O(1).
+func (c *SyncCache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Stats()
+}
+
+// ResetStats sets all statistics counters back to zero.
+// Time complexity: O(1).
+func (c *SyncCache[K, V]) ResetStats() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.ResetStats()
+}
+
+// Keys returns the keys in the cache from least to most recently used.
+// Time complexity: O(n).
+func (c *SyncCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Keys()
+}
+
+// Values returns the values in the cache from least to most recently used.
+// Time complexity: O(n).
+func (c *SyncCache[K, V]) Values() []V {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Values()
+}
+
+// ToSlice returns the entries in the cache from least to most recently used.
+// Time complexity: O(n).
+func (c *SyncCache[K, V]) ToSlice() []orderedhashmap.KVPair[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.ToSlice()
+}
+
+// All returns an iterator over a snapshot of the entries from least to most recently used.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the cache.
+// Time complexity: O(n) time and O(n) space.
+func (c *SyncCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, pair := range c.ToSlice() {
+			if !yield(pair.Key, pair.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over a snapshot of the entries from most to least recently used.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the cache.
+// Time complexity: O(n) time and O(n) space.
+func (c *SyncCache[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		pairs := c.ToSlice()
+		for i := len(pairs) - 1; i >= 0; i-- {
+			if !yield(pairs[i].Key, pairs[i].Value) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all entries without calling the eviction callback.
+// Time complexity: O(1).
+func (c *SyncCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Clear()
+}
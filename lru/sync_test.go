@@ -0,0 +1,84 @@
+package lru
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncCacheOperations(t *testing.T) {
+	c := NewSync(New[string, int](2))
+	if !c.IsEmpty() || c.Capacity() != 2 {
+		t.Error("Expected new cache to be empty with capacity 2")
+	}
+
+	if actual, loaded := c.GetOrSet("a", 1); loaded || actual != 1 {
+		t.Errorf("Expected (1, false), got (%d, %t)", actual, loaded)
+	}
+	if actual, loaded := c.GetOrSet("a", 100); !loaded || actual != 1 {
+		t.Errorf("Expected (1, true), got (%d, %t)", actual, loaded)
+	}
+	c.Set("b", 2)
+	c.Get("a")
+	if !c.Set("c", 3) {
+		t.Error("Expected Set to evict")
+	}
+	if c.Contains("b") {
+		t.Error("Expected b to be evicted")
+	}
+	if v, ok := c.Peek("a"); !ok || v != 1 {
+		t.Errorf("Expected (1, true), got (%d, %t)", v, ok)
+	}
+	if k, _, _ := c.Oldest(); k != "a" {
+		t.Errorf("Expected oldest a, got %s", k)
+	}
+
+	expected := Stats{Hits: 2, Misses: 1, Evictions: 1}
+	if c.Stats() != expected {
+		t.Errorf("Expected %+v, got %+v", expected, c.Stats())
+	}
+
+	for k := range c.All() {
+		c.Delete(k) // modifying during iteration must not deadlock
+	}
+	if c.Size() != 0 {
+		t.Errorf("Expected empty cache, got size %d", c.Size())
+	}
+}
+
+func TestSyncCacheConcurrent(t *testing.T) {
+	const goroutines = 32
+	const ops = 2000
+	const capacity = 64
+
+	var evictions int
+	c := NewSync(NewWithEvict(capacity, func(int, int) { evictions++ }))
+	var wg sync.WaitGroup
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < ops; i++ {
+				key := (g*ops + i) % (capacity * 4)
+				if _, ok := c.Get(key); !ok {
+					c.GetOrSet(key, key)
+				}
+				if i%50 == 0 {
+					c.Keys()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if c.Size() > capacity {
+		t.Errorf("Expected at most %d entries, got %d", capacity, c.Size())
+	}
+	stats := c.Stats()
+	if stats.Hits+stats.Misses < goroutines*ops {
+		t.Errorf("Expected at least %d lookups, got %d", goroutines*ops, stats.Hits+stats.Misses)
+	}
+	if uint64(evictions) != stats.Evictions {
+		t.Errorf("Expected %d callback calls, got %d", stats.Evictions, evictions)
+	}
+}
@@ -11,3 +11,15 @@ type LinkedNode[T any] struct {
 	Value T              // the value stored in this node
 	Next  *LinkedNode[T] // pointer to the next node, nil if this is the last node
 }
+
+// DoublyLinkedNode represents a node in a doubly linked list.
+// It contains a value of type T and pointers to both the previous and next nodes,
+// which allows a node to be unlinked in O(1) time given only a pointer to it.
+//
+// Type parameters:
+//   - T: the value type, can be any type
+type DoublyLinkedNode[T any] struct {
+	Value T                    // the value stored in this node
+	Prev  *DoublyLinkedNode[T] // pointer to the previous node, nil if this is the first node
+	Next  *DoublyLinkedNode[T] // pointer to the next node, nil if this is the last node
+}
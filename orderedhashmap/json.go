@@ -0,0 +1,180 @@
+package orderedhashmap
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// MarshalJSON implements json.Marshaler.
+// The map is encoded as a JSON object whose members appear in insertion order.
+// Keys follow the rules of encoding/json for map keys: string kinds are used directly,
+// types implementing encoding.TextMarshaler are marshaled as text, and integer kinds
+// are formatted in base 10. Any other key type results in an error.
+// Time complexity: O(n) where n is the number of key-value pairs.
+//
+// Returns:
+//   - the JSON encoding of the map
+//   - an error if a key type is unsupported or a value cannot be marshaled
+//
+// Example:
+//
+//	ohm := New[string, int]()
+//	ohm.Set("b", 2)
+//	ohm.Set("a", 1)
+//	data, _ := json.Marshal(ohm)  // {"b":2,"a":1}
+func (ohm *OrderedHashMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for node := ohm.first; node != nil; node = node.Next {
+		if node != ohm.first {
+			buf.WriteByte(',')
+		}
+		name, err := encodeKey(node.Value.Key)
+		if err != nil {
+			return nil, err
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(node.Value.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It replaces the contents of the map with the members of a JSON object, in the order they
+// appear in the document. If a key appears more than once, the last value wins but the key
+// keeps the position of its first occurrence. A JSON null leaves the map unchanged.
+// Keys follow the rules of encoding/json for map keys: types whose pointer implements
+// encoding.TextUnmarshaler are unmarshaled from text, otherwise string and integer kinds are supported.
+// If an error occurs the map is left unchanged.
+// Time complexity: O(n) where n is the number of object members.
+//
+// Parameters:
+//   - data: the JSON encoding of an object
+//
+// Returns:
+//   - an error if data is not a JSON object or a key or value cannot be decoded
+//
+// Example:
+//
+//	ohm := New[string, int]()
+//	_ = json.Unmarshal([]byte(`{"b":2,"a":1}`), ohm)
+//	ohm.Keys()  // [b a]
+func (ohm *OrderedHashMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return &json.UnmarshalTypeError{Value: describeToken(tok), Type: reflect.TypeOf(ohm)}
+	}
+
+	decoded := New[K, V]()
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, err := decodeKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		decoded.Set(key, value)
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	*ohm = *decoded
+	return nil
+}
+
+// encodeKey converts a map key to the name of a JSON object member.
+func encodeKey(key any) (string, error) {
+	v := reflect.ValueOf(key)
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+	if tm, ok := key.(encoding.TextMarshaler); ok {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return "", nil
+		}
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("orderedhashmap: unsupported key type %T", key)
+}
+
+// decodeKey converts the name of a JSON object member to a map key.
+func decodeKey[K comparable](name string) (K, error) {
+	var key K
+	if tu, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(name))
+		return key, err
+	}
+	v := reflect.ValueOf(&key).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(name)
+		return key, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(name, 10, 64)
+		if err != nil || v.OverflowInt(n) {
+			return key, &json.UnmarshalTypeError{Value: "number " + name, Type: v.Type()}
+		}
+		v.SetInt(n)
+		return key, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(name, 10, 64)
+		if err != nil || v.OverflowUint(n) {
+			return key, &json.UnmarshalTypeError{Value: "number " + name, Type: v.Type()}
+		}
+		v.SetUint(n)
+		return key, nil
+	}
+	return key, fmt.Errorf("orderedhashmap: unsupported key type %s", v.Type())
+}
+
+// describeToken names the kind of JSON value a token starts, for error messages.
+func describeToken(tok json.Token) string {
+	switch tok := tok.(type) {
+	case json.Delim:
+		if tok == '[' {
+			return "array"
+		}
+		return "object"
+	case bool:
+		return "bool"
+	case float64, json.Number:
+		return "number"
+	case string:
+		return "string"
+	}
+	return "value"
+}
@@ -0,0 +1,281 @@
+package orderedhashmap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// point is a comparable key type that implements encoding.TextMarshaler and encoding.TextUnmarshaler.
+type point struct {
+	X, Y int
+}
+
+func (p point) MarshalText() ([]byte, error) {
+	return fmt.Appendf(nil, "%d,%d", p.X, p.Y), nil
+}
+
+func (p *point) UnmarshalText(text []byte) error {
+	_, err := fmt.Sscanf(string(text), "%d,%d", &p.X, &p.Y)
+	return err
+}
+
+// color is a string kind key, used to check that named string types are supported.
+type color string
+
+func TestOrderedHashMap_MarshalJSON(t *testing.T) {
+	t.Run("string keys keep insertion order", func(t *testing.T) {
+		ohm := New[string, int]()
+		ohm.Set("zeta", 1)
+		ohm.Set("alpha", 2)
+		ohm.Set("mid", 3)
+		data, err := json.Marshal(ohm)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if expected := `{"zeta":1,"alpha":2,"mid":3}`; string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		data, err := json.Marshal(New[string, int]())
+		if err != nil || string(data) != "{}" {
+			t.Errorf("Expected {}, got %s (%v)", data, err)
+		}
+	})
+
+	t.Run("integer keys", func(t *testing.T) {
+		ohm := New[int64, string]()
+		ohm.Set(10, "ten")
+		ohm.Set(-2, "minus two")
+		data, err := json.Marshal(ohm)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if expected := `{"10":"ten","-2":"minus two"}`; string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+
+	t.Run("TextMarshaler and named string keys", func(t *testing.T) {
+		points := New[point, bool]()
+		points.Set(point{3, 4}, true)
+		points.Set(point{1, 2}, false)
+		data, err := json.Marshal(points)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if expected := `{"3,4":true,"1,2":false}`; string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+
+		colors := New[color, int]()
+		colors.Set("red", 1)
+		if data, _ := json.Marshal(colors); string(data) != `{"red":1}` {
+			t.Errorf(`Expected {"red":1}, got %s`, data)
+		}
+	})
+
+	t.Run("keys and values are escaped", func(t *testing.T) {
+		ohm := New[string, string]()
+		ohm.Set(`quote"key`, "line\nbreak")
+		data, err := json.Marshal(ohm)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if expected := `{"quote\"key":"line\nbreak"}`; string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+
+	t.Run("nested in a struct", func(t *testing.T) {
+		inner := New[string, int]()
+		inner.Set("b", 1)
+		inner.Set("a", 2)
+		doc := struct {
+			Name   string                       `json:"name"`
+			Fields *OrderedHashMap[string, int] `json:"fields"`
+		}{"cfg", inner}
+		data, err := json.Marshal(doc)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if expected := `{"name":"cfg","fields":{"b":1,"a":2}}`; string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+
+	t.Run("unsupported key type", func(t *testing.T) {
+		ohm := New[float64, int]()
+		ohm.Set(1.5, 1)
+		if _, err := json.Marshal(ohm); err == nil {
+			t.Error("Expected error for float64 keys")
+		}
+	})
+}
+
+func TestOrderedHashMap_UnmarshalJSON(t *testing.T) {
+	t.Run("preserves document order", func(t *testing.T) {
+		ohm := New[string, int]()
+		if err := json.Unmarshal([]byte(`{"zeta":1, "alpha":2, "mid":3}`), ohm); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(ohm.Keys(), []string{"zeta", "alpha", "mid"}) {
+			t.Errorf("Expected keys [zeta alpha mid], got %v", ohm.Keys())
+		}
+		if !reflect.DeepEqual(ohm.Values(), []int{1, 2, 3}) {
+			t.Errorf("Expected values [1 2 3], got %v", ohm.Values())
+		}
+	})
+
+	t.Run("replaces existing contents", func(t *testing.T) {
+		ohm := New[string, int]()
+		ohm.Set("old", 1)
+		if err := json.Unmarshal([]byte(`{"new":2}`), ohm); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(ohm.Keys(), []string{"new"}) {
+			t.Errorf("Expected keys [new], got %v", ohm.Keys())
+		}
+	})
+
+	t.Run("duplicate keys keep first position and last value", func(t *testing.T) {
+		ohm := New[string, int]()
+		if err := json.Unmarshal([]byte(`{"a":1,"b":2,"a":3}`), ohm); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(ohm.ToSlice(), []KVPair[string, int]{{"a", 3}, {"b", 2}}) {
+			t.Errorf("Unexpected contents %v", ohm.ToSlice())
+		}
+	})
+
+	t.Run("integer, TextUnmarshaler and named string keys", func(t *testing.T) {
+		ints := New[uint8, string]()
+		if err := json.Unmarshal([]byte(`{"200":"a","7":"b"}`), ints); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(ints.Keys(), []uint8{200, 7}) {
+			t.Errorf("Expected keys [200 7], got %v", ints.Keys())
+		}
+
+		points := New[point, int]()
+		if err := json.Unmarshal([]byte(`{"5,6":1,"1,2":2}`), points); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(points.Keys(), []point{{5, 6}, {1, 2}}) {
+			t.Errorf("Expected keys [{5 6} {1 2}], got %v", points.Keys())
+		}
+
+		colors := New[color, int]()
+		if err := json.Unmarshal([]byte(`{"blue":1}`), colors); err != nil || colors.Keys()[0] != "blue" {
+			t.Errorf("Expected key blue, got %v (%v)", colors.Keys(), err)
+		}
+	})
+
+	t.Run("nested values and zero value target", func(t *testing.T) {
+		var doc struct {
+			Fields *OrderedHashMap[string, []int] `json:"fields"`
+		}
+		if err := json.Unmarshal([]byte(`{"fields":{"y":[1,2],"x":[]}}`), &doc); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(doc.Fields.Keys(), []string{"y", "x"}) {
+			t.Errorf("Expected keys [y x], got %v", doc.Fields.Keys())
+		}
+		if v, _ := doc.Fields.Get("y"); !reflect.DeepEqual(v, []int{1, 2}) {
+			t.Errorf("Expected [1 2], got %v", v)
+		}
+	})
+
+	t.Run("null leaves the map unchanged", func(t *testing.T) {
+		ohm := New[string, int]()
+		ohm.Set("a", 1)
+		if err := ohm.UnmarshalJSON([]byte("null")); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if ohm.Size() != 1 {
+			t.Errorf("Expected size 1, got %d", ohm.Size())
+		}
+	})
+
+	t.Run("errors leave the map unchanged", func(t *testing.T) {
+		tests := []struct {
+			name string
+			data string
+		}{
+			{"array", `[1,2]`},
+			{"string", `"text"`},
+			{"integer key overflow", `{"300":"x"}`},
+			{"non-integer key", `{"abc":"x"}`},
+			{"value type mismatch", `{"1":2}`},
+		}
+		for _, tt := range tests {
+			ohm := New[uint8, string]()
+			ohm.Set(1, "keep")
+			if err := json.Unmarshal([]byte(tt.data), ohm); err == nil {
+				t.Errorf("%s: expected error", tt.name)
+			}
+			if !reflect.DeepEqual(ohm.Keys(), []uint8{1}) {
+				t.Errorf("%s: expected map to be unchanged, got %v", tt.name, ohm.Keys())
+			}
+		}
+	})
+
+	t.Run("type error for non-object", func(t *testing.T) {
+		var typeErr *json.UnmarshalTypeError
+		if err := json.Unmarshal([]byte(`[1]`), New[string, int]()); !errors.As(err, &typeErr) || typeErr.Value != "array" {
+			t.Errorf("Expected UnmarshalTypeError for array, got %v", err)
+		}
+	})
+}
+
+func TestOrderedHashMap_JSONRoundTrip(t *testing.T) {
+	ohm := New[int, map[string]any]()
+	for i := 10; i > 0; i-- {
+		ohm.Set(i*7, map[string]any{"n": float64(i), "even": i%2 == 0})
+	}
+	data, err := json.Marshal(ohm)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := New[int, map[string]any]()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.ToSlice(), ohm.ToSlice()) {
+		t.Errorf("Expected %v, got %v", ohm.ToSlice(), decoded.ToSlice())
+	}
+}
+
+func TestSyncOrderedHashMap_JSON(t *testing.T) {
+	m := NewSync[string, int](nil)
+	m.Set("b", 1)
+	m.Set("a", 2)
+	data, err := json.Marshal(m)
+	if err != nil || string(data) != `{"b":1,"a":2}` {
+		t.Errorf(`Expected {"b":1,"a":2}, got %s (%v)`, data, err)
+	}
+
+	var decoded SyncOrderedHashMap[string, int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Keys(), []string{"b", "a"}) {
+		t.Errorf("Expected keys [b a], got %v", decoded.Keys())
+	}
+}
+
+func TestSyncOrderedHashMap_ZeroValueJSON(t *testing.T) {
+	var zero SyncOrderedHashMap[string, int]
+	data, err := json.Marshal(&zero)
+	if err != nil || string(data) != "{}" {
+		t.Errorf("Expected {}, got %s (%v)", data, err)
+	}
+	var decoded SyncOrderedHashMap[string, int]
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Size() != 0 {
+		t.Errorf("Expected empty map, got %v (%v)", decoded.Keys(), err)
+	}
+}
@@ -2,20 +2,25 @@
 // insertion order of key-value pairs while providing O(1) access, insertion, and deletion.
 package orderedhashmap
 
-import "github.com/thefrost13/gollections/node"
+import (
+	"iter"
+
+	"github.com/thefrost13/gollections/node"
+)
 
 // OrderedHashMap is a generic hash map that maintains the insertion order of key-value pairs.
-// It combines the fast access of a hash map with the ordered iteration of a linked list.
+// It combines the fast access of a hash map with the ordered iteration of a doubly linked list,
+// so every node can be unlinked in constant time.
 // The zero value is ready to use but New should be preferred for initialization.
 //
 // Type parameters:
 //   - K: the key type, must be comparable
 //   - V: the value type, can be any type
 type OrderedHashMap[K comparable, V any] struct {
-	first *node.LinkedNode[KVPair[K, V]]       // pointer to the first node in insertion order
-	last  *node.LinkedNode[KVPair[K, V]]       // pointer to the last node in insertion order
-	size  int                                  // number of key-value pairs in the map
-	m     map[K]*node.LinkedNode[KVPair[K, V]] // hash map for O(1) key lookup
+	first *node.DoublyLinkedNode[KVPair[K, V]]       // pointer to the first node in insertion order
+	last  *node.DoublyLinkedNode[KVPair[K, V]]       // pointer to the last node in insertion order
+	size  int                                        // number of key-value pairs in the map
+	m     map[K]*node.DoublyLinkedNode[KVPair[K, V]] // hash map for O(1) key lookup
 }
 
 // KVPair represents a key-value pair stored in the OrderedHashMap.
@@ -35,10 +40,31 @@ type KVPair[K comparable, V any] struct {
 //	ohm.Set("second", 2)
 func New[K comparable, V any]() *OrderedHashMap[K, V] {
 	return &OrderedHashMap[K, V]{
-		m: make(map[K]*node.LinkedNode[KVPair[K, V]]),
+		m: make(map[K]*node.DoublyLinkedNode[KVPair[K, V]]),
 	}
 }
 
+// FromSeq2 creates and returns a new OrderedHashMap containing the key-value pairs yielded by seq.
+// Keys are inserted in the order they are yielded; a repeated key updates the value but keeps its first position.
+// Time complexity: O(n) where n is the number of pairs yielded.
+//
+// Parameters:
+//   - seq: the sequence of key-value pairs to initialize the map with
+//
+// Returns:
+//   - a new OrderedHashMap containing the pairs from the sequence
+//
+// Example:
+//
+//	ohm := FromSeq2(other.All())  // Copy another map preserving order
+func FromSeq2[K comparable, V any](seq iter.Seq2[K, V]) *OrderedHashMap[K, V] {
+	ohm := New[K, V]()
+	for key, value := range seq {
+		ohm.Set(key, value)
+	}
+	return ohm
+}
+
 // Set inserts or updates a key-value pair in the OrderedHashMap.
 // If the key already exists, its value is updated but its position in the insertion order is preserved.
 // If the key is new, it is added to the end of the insertion order.
@@ -58,8 +84,9 @@ func (ohm *OrderedHashMap[K, V]) Set(key K, value V) {
 		return
 	}
 
-	newItem := &node.LinkedNode[KVPair[K, V]]{
+	newItem := &node.DoublyLinkedNode[KVPair[K, V]]{
 		Value: KVPair[K, V]{Key: key, Value: value},
+		Prev:  ohm.last,
 	}
 
 	if ohm.first == nil {
@@ -100,7 +127,7 @@ func (ohm *OrderedHashMap[K, V]) Get(key K) (V, bool) {
 // Delete removes the key-value pair with the given key from the OrderedHashMap.
 // If the key doesn't exist, the operation is a no-op.
 // The insertion order of remaining elements is preserved.
-// Time complexity: O(n) worst case due to linked list traversal.
+// Time complexity: O(1) average case.
 //
 // Parameters:
 //   - key: the key to remove from the map
@@ -109,33 +136,128 @@ func (ohm *OrderedHashMap[K, V]) Get(key K) (V, bool) {
 //
 //	ohm.Delete("name")  // Remove the key-value pair with key "name"
 func (ohm *OrderedHashMap[K, V]) Delete(key K) {
-	if node, exists := ohm.m[key]; exists {
-		delete(ohm.m, key)
-		if node == ohm.first {
-			ohm.first = node.Next
-		} else {
-			prev := ohm.first
-			for prev != nil && prev.Next != node {
-				prev = prev.Next
-			}
-			if prev != nil {
-				prev.Next = node.Next
-			}
-		}
-		if node == ohm.last {
-			// Find the previous node to update last pointer
-			if ohm.first == nil {
-				ohm.last = nil
-			} else {
-				prev := ohm.first
-				for prev != nil && prev.Next != nil {
-					prev = prev.Next
-				}
-				ohm.last = prev
-			}
-		}
-		ohm.size--
+	node, exists := ohm.m[key]
+	if !exists {
+		return
+	}
+	delete(ohm.m, key)
+	ohm.unlink(node)
+	ohm.size--
+}
+
+// MoveToBack moves the key-value pair with the given key to the end of the order,
+// as if it had just been inserted. The value is left unchanged.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to move
+//
+// Returns:
+//   - true if the key exists in the map, false otherwise
+//
+// Example:
+//
+//	ohm.MoveToBack("name")  // "name" is now the last key
+func (ohm *OrderedHashMap[K, V]) MoveToBack(key K) bool {
+	node, exists := ohm.m[key]
+	if !exists {
+		return false
+	}
+	if node != ohm.last {
+		ohm.unlink(node)
+		node.Prev = ohm.last
+		ohm.last.Next = node
+		ohm.last = node
+	}
+	return true
+}
+
+// MoveToFront moves the key-value pair with the given key to the start of the order.
+// The value is left unchanged.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to move
+//
+// Returns:
+//   - true if the key exists in the map, false otherwise
+//
+// Example:
+//
+//	ohm.MoveToFront("name")  // "name" is now the first key
+func (ohm *OrderedHashMap[K, V]) MoveToFront(key K) bool {
+	node, exists := ohm.m[key]
+	if !exists {
+		return false
+	}
+	if node != ohm.first {
+		ohm.unlink(node)
+		node.Next = ohm.first
+		ohm.first.Prev = node
+		ohm.first = node
+	}
+	return true
+}
+
+// First returns the first key-value pair in the order without removing it.
+// Time complexity: O(1).
+//
+// Returns:
+//   - key: the first key, or zero value if the map is empty
+//   - value: the value associated with the first key, or zero value if the map is empty
+//   - ok: true if the map is not empty, false otherwise
+//
+// Example:
+//
+//	if key, value, ok := ohm.First(); ok {
+//	    fmt.Printf("Oldest: %v=%v\n", key, value)
+//	}
+func (ohm *OrderedHashMap[K, V]) First() (K, V, bool) {
+	if ohm.first == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	return ohm.first.Value.Key, ohm.first.Value.Value, true
+}
+
+// Last returns the last key-value pair in the order without removing it.
+// Time complexity: O(1).
+//
+// Returns:
+//   - key: the last key, or zero value if the map is empty
+//   - value: the value associated with the last key, or zero value if the map is empty
+//   - ok: true if the map is not empty, false otherwise
+//
+// Example:
+//
+//	if key, value, ok := ohm.Last(); ok {
+//	    fmt.Printf("Newest: %v=%v\n", key, value)
+//	}
+func (ohm *OrderedHashMap[K, V]) Last() (K, V, bool) {
+	if ohm.last == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
 	}
+	return ohm.last.Value.Key, ohm.last.Value.Value, true
+}
+
+// unlink detaches item from the linked list and clears its links.
+// It does not touch the lookup map or the size.
+func (ohm *OrderedHashMap[K, V]) unlink(item *node.DoublyLinkedNode[KVPair[K, V]]) {
+	if item.Prev == nil {
+		ohm.first = item.Next
+	} else {
+		item.Prev.Next = item.Next
+	}
+	if item.Next == nil {
+		ohm.last = item.Prev
+	} else {
+		item.Next.Prev = item.Prev
+	}
+	item.Prev = nil
+	item.Next = nil
 }
 
 // Size returns the number of key-value pairs in the OrderedHashMap.
@@ -226,3 +348,93 @@ func (ohm *OrderedHashMap[K, V]) ToSlice() []KVPair[K, V] {
 	}
 	return slice
 }
+
+// All returns an iterator over the key-value pairs of the OrderedHashMap in insertion order.
+// The map must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, with no allocation of an intermediate slice.
+//
+// Returns:
+//   - an iter.Seq2 yielding each key and its value in insertion order
+//
+// Example:
+//
+//	for key, value := range ohm.All() {
+//	    fmt.Printf("%v: %v\n", key, value)
+//	}
+func (ohm *OrderedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := ohm.first; node != nil; node = node.Next {
+			if !yield(node.Value.Key, node.Value.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the key-value pairs of the OrderedHashMap in reverse insertion order.
+// The map must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, with no allocation of an intermediate slice.
+//
+// Returns:
+//   - an iter.Seq2 yielding each key and its value, most recently inserted first
+//
+// Example:
+//
+//	for key, value := range ohm.Backward() {
+//	    fmt.Printf("%v: %v\n", key, value)
+//	}
+func (ohm *OrderedHashMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := ohm.last; node != nil; node = node.Prev {
+			if !yield(node.Value.Key, node.Value.Value) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over the keys of the OrderedHashMap in insertion order.
+// It is the allocation-free counterpart of Keys.
+// The map must not be modified during iteration.
+// Time complexity: O(n) for a full iteration.
+//
+// Returns:
+//   - an iter.Seq yielding each key in insertion order
+//
+// Example:
+//
+//	for key := range ohm.KeysSeq() {
+//	    fmt.Println(key)
+//	}
+func (ohm *OrderedHashMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for node := ohm.first; node != nil; node = node.Next {
+			if !yield(node.Value.Key) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over the values of the OrderedHashMap in insertion order.
+// It is the allocation-free counterpart of Values.
+// The map must not be modified during iteration.
+// Time complexity: O(n) for a full iteration.
+//
+// Returns:
+//   - an iter.Seq yielding each value in insertion order
+//
+// Example:
+//
+//	for value := range ohm.ValuesSeq() {
+//	    fmt.Println(value)
+//	}
+func (ohm *OrderedHashMap[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for node := ohm.first; node != nil; node = node.Next {
+			if !yield(node.Value.Value) {
+				return
+			}
+		}
+	}
+}
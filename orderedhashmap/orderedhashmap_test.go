@@ -2,6 +2,7 @@ package orderedhashmap
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -582,3 +583,306 @@ func TestOrderedHashMap_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestOrderedHashMap_FromSeq2(t *testing.T) {
+	src := New[string, int]()
+	src.Set("a", 1)
+	src.Set("b", 2)
+	src.Set("c", 3)
+
+	ohm := FromSeq2(src.All())
+	if !reflect.DeepEqual(ohm.ToSlice(), src.ToSlice()) {
+		t.Errorf("Expected %v, got %v", src.ToSlice(), ohm.ToSlice())
+	}
+
+	ohm.Set("d", 4)
+	if src.Size() != 3 {
+		t.Error("Expected source map to be unaffected")
+	}
+}
+
+func TestOrderedHashMap_Iterators(t *testing.T) {
+	ohm := New[string, int]()
+	ohm.Set("one", 1)
+	ohm.Set("two", 2)
+	ohm.Set("three", 3)
+	ohm.Delete("two")
+	ohm.Set("four", 4)
+
+	t.Run("all yields insertion order", func(t *testing.T) {
+		var keys []string
+		var values []int
+		for k, v := range ohm.All() {
+			keys = append(keys, k)
+			values = append(values, v)
+		}
+		if !reflect.DeepEqual(keys, ohm.Keys()) {
+			t.Errorf("Expected keys %v, got %v", ohm.Keys(), keys)
+		}
+		if !reflect.DeepEqual(values, ohm.Values()) {
+			t.Errorf("Expected values %v, got %v", ohm.Values(), values)
+		}
+	})
+
+	t.Run("backward yields reverse insertion order", func(t *testing.T) {
+		var keys []string
+		for k := range ohm.Backward() {
+			keys = append(keys, k)
+		}
+		expected := []string{"four", "three", "one"}
+		if !reflect.DeepEqual(keys, expected) {
+			t.Errorf("Expected %v, got %v", expected, keys)
+		}
+	})
+
+	t.Run("keys and values sequences", func(t *testing.T) {
+		if got := slices.Collect(ohm.KeysSeq()); !reflect.DeepEqual(got, ohm.Keys()) {
+			t.Errorf("Expected %v, got %v", ohm.Keys(), got)
+		}
+		if got := slices.Collect(ohm.ValuesSeq()); !reflect.DeepEqual(got, ohm.Values()) {
+			t.Errorf("Expected %v, got %v", ohm.Values(), got)
+		}
+	})
+
+	t.Run("early break", func(t *testing.T) {
+		count := 0
+		for range ohm.All() {
+			count++
+			break
+		}
+		for range ohm.Backward() {
+			count++
+			break
+		}
+		for range ohm.KeysSeq() {
+			count++
+			break
+		}
+		for range ohm.ValuesSeq() {
+			count++
+			break
+		}
+		if count != 4 {
+			t.Errorf("Expected 4 iterations, got %d", count)
+		}
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		empty := New[string, int]()
+		for range empty.All() {
+			t.Error("Expected no iterations over empty map")
+		}
+		for range empty.Backward() {
+			t.Error("Expected no iterations over empty map")
+		}
+	})
+}
+
+func TestOrderedHashMap_DeleteLinks(t *testing.T) {
+	t.Run("prev links stay consistent after deletes", func(t *testing.T) {
+		ohm := New[int, int]()
+		for i := 0; i < 10; i++ {
+			ohm.Set(i, i)
+		}
+		for _, k := range []int{0, 9, 5, 3, 8} {
+			ohm.Delete(k)
+		}
+
+		var forward []int
+		for k := range ohm.All() {
+			forward = append(forward, k)
+		}
+		var backward []int
+		for k := range ohm.Backward() {
+			backward = append(backward, k)
+		}
+		slices.Reverse(backward)
+
+		expected := []int{1, 2, 4, 6, 7}
+		if !reflect.DeepEqual(forward, expected) {
+			t.Errorf("Expected forward %v, got %v", expected, forward)
+		}
+		if !reflect.DeepEqual(backward, expected) {
+			t.Errorf("Expected backward %v, got %v", expected, backward)
+		}
+		if ohm.first.Prev != nil || ohm.last.Next != nil {
+			t.Error("Expected list ends to have nil outer links")
+		}
+	})
+
+	t.Run("delete everything then reuse", func(t *testing.T) {
+		ohm := New[string, int]()
+		ohm.Set("a", 1)
+		ohm.Set("b", 2)
+		ohm.Delete("b")
+		ohm.Delete("a")
+		if ohm.first != nil || ohm.last != nil {
+			t.Error("Expected first and last to be nil after deleting everything")
+		}
+		ohm.Set("c", 3)
+		if ohm.first != ohm.last || ohm.first.Prev != nil {
+			t.Error("Expected single node after reuse")
+		}
+	})
+}
+
+func TestOrderedHashMap_Move(t *testing.T) {
+	newMap := func() *OrderedHashMap[string, int] {
+		ohm := New[string, int]()
+		for i, k := range []string{"a", "b", "c", "d"} {
+			ohm.Set(k, i)
+		}
+		return ohm
+	}
+	checkOrder := func(t *testing.T, ohm *OrderedHashMap[string, int], expected []string) {
+		t.Helper()
+		if keys := ohm.Keys(); !reflect.DeepEqual(keys, expected) {
+			t.Errorf("Expected keys %v, got %v", expected, keys)
+		}
+		var backward []string
+		for k := range ohm.Backward() {
+			backward = append(backward, k)
+		}
+		slices.Reverse(backward)
+		if !reflect.DeepEqual(backward, expected) {
+			t.Errorf("Expected backward keys %v, got %v", expected, backward)
+		}
+	}
+
+	t.Run("MoveToBack", func(t *testing.T) {
+		ohm := newMap()
+		if !ohm.MoveToBack("a") {
+			t.Error("Expected MoveToBack to find existing key")
+		}
+		checkOrder(t, ohm, []string{"b", "c", "d", "a"})
+		ohm.MoveToBack("c")
+		checkOrder(t, ohm, []string{"b", "d", "a", "c"})
+		ohm.MoveToBack("c")
+		checkOrder(t, ohm, []string{"b", "d", "a", "c"})
+		if v, _ := ohm.Get("a"); v != 0 {
+			t.Errorf("Expected value 0, got %d", v)
+		}
+	})
+
+	t.Run("MoveToFront", func(t *testing.T) {
+		ohm := newMap()
+		if !ohm.MoveToFront("d") {
+			t.Error("Expected MoveToFront to find existing key")
+		}
+		checkOrder(t, ohm, []string{"d", "a", "b", "c"})
+		ohm.MoveToFront("b")
+		checkOrder(t, ohm, []string{"b", "d", "a", "c"})
+		ohm.MoveToFront("b")
+		checkOrder(t, ohm, []string{"b", "d", "a", "c"})
+	})
+
+	t.Run("Missing key", func(t *testing.T) {
+		ohm := newMap()
+		if ohm.MoveToBack("z") || ohm.MoveToFront("z") {
+			t.Error("Expected moves of a missing key to return false")
+		}
+		checkOrder(t, ohm, []string{"a", "b", "c", "d"})
+	})
+
+	t.Run("Single element", func(t *testing.T) {
+		ohm := New[string, int]()
+		ohm.Set("only", 1)
+		ohm.MoveToBack("only")
+		ohm.MoveToFront("only")
+		checkOrder(t, ohm, []string{"only"})
+		if ohm.first != ohm.last {
+			t.Error("Expected first and last to be the same node")
+		}
+	})
+}
+
+func TestOrderedHashMap_FirstLast(t *testing.T) {
+	ohm := New[string, int]()
+	if _, _, ok := ohm.First(); ok {
+		t.Error("Expected First to report false on empty map")
+	}
+	if _, _, ok := ohm.Last(); ok {
+		t.Error("Expected Last to report false on empty map")
+	}
+
+	ohm.Set("a", 1)
+	ohm.Set("b", 2)
+	ohm.Set("c", 3)
+	if k, v, ok := ohm.First(); !ok || k != "a" || v != 1 {
+		t.Errorf("Expected (a, 1, true), got (%s, %d, %v)", k, v, ok)
+	}
+	if k, v, ok := ohm.Last(); !ok || k != "c" || v != 3 {
+		t.Errorf("Expected (c, 3, true), got (%s, %d, %v)", k, v, ok)
+	}
+
+	ohm.MoveToBack("a")
+	if k, _, _ := ohm.First(); k != "b" {
+		t.Errorf("Expected first key b, got %s", k)
+	}
+	if k, _, _ := ohm.Last(); k != "a" {
+		t.Errorf("Expected last key a, got %s", k)
+	}
+}
+
+// Benchmark tests
+func benchmarkMap(n int) *OrderedHashMap[int, int] {
+	ohm := New[int, int]()
+	for i := 0; i < n; i++ {
+		ohm.Set(i, i)
+	}
+	return ohm
+}
+
+func BenchmarkOrderedHashMapSet(b *testing.B) {
+	ohm := New[int, int]()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ohm.Set(i, i)
+	}
+}
+
+func BenchmarkOrderedHashMapGet(b *testing.B) {
+	ohm := benchmarkMap(1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ohm.Get(i % 1000)
+	}
+}
+
+// BenchmarkOrderedHashMapDelete measures Delete on a map holding 1M entries.
+// Each deleted key is re-inserted so the map size stays constant.
+func BenchmarkOrderedHashMapDelete(b *testing.B) {
+	const n = 1_000_000
+
+	b.Run("first", func(b *testing.B) {
+		ohm := benchmarkMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			key := ohm.first.Value.Key
+			ohm.Delete(key)
+			ohm.Set(key, i)
+		}
+	})
+
+	b.Run("last", func(b *testing.B) {
+		ohm := benchmarkMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			key := ohm.last.Value.Key
+			ohm.Delete(key)
+			ohm.Set(key, i)
+		}
+	})
+
+	b.Run("scattered", func(b *testing.B) {
+		ohm := benchmarkMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			key := (i * 7919) % n
+			ohm.Delete(key)
+			ohm.Set(key, i)
+		}
+	})
+}
@@ -0,0 +1,273 @@
+package orderedhashmap
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncOrderedHashMap is an OrderedHashMap that is safe for concurrent use by multiple goroutines.
+// Reads take a shared lock and writes take an exclusive lock on a sync.RWMutex,
+// and compound operations such as GetOrSet are performed atomically.
+//
+// Type parameters:
+//   - K: the key type, must be comparable
+//   - V: the value type, can be any type
+type SyncOrderedHashMap[K comparable, V any] struct {
+	mu  sync.RWMutex          // guards ohm
+	ohm *OrderedHashMap[K, V] // the wrapped map
+}
+
+// NewSync creates and returns a new SyncOrderedHashMap wrapping the given map.
+// The caller must not use ohm directly afterwards. If ohm is nil, an empty map is used.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - ohm: the OrderedHashMap to guard, can be nil
+//
+// Returns:
+//   - a new SyncOrderedHashMap guarding ohm
+//
+// Example:
+//
+//	m := NewSync[string, int](nil)
+//	go m.Set("hits", 1)
+func NewSync[K comparable, V any](ohm *OrderedHashMap[K, V]) *SyncOrderedHashMap[K, V] {
+	if ohm == nil {
+		ohm = New[K, V]()
+	}
+	return &SyncOrderedHashMap[K, V]{ohm: ohm}
+}
+
+// Set inserts or updates a key-value pair, preserving the position of existing keys.
+// Time complexity: O(1) average case.
+func (m *SyncOrderedHashMap[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ohm.Set(key, value)
+}
+
+// Get retrieves the value associated with the given key.
+// Time complexity: O(1) average case.
+func (m *SyncOrderedHashMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ohm.Get(key)
+}
+
+// GetOrSet returns the existing value for key if present.
+// Otherwise it stores value at the end of the insertion order and returns it.
+// The lookup and the insertion happen atomically.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to look up or insert
+//   - value: the value to store if key is absent
+//
+// Returns:
+//   - actual: the existing value if key was present, otherwise value
+//   - loaded: true if the value was already present, false if it was stored
+//
+// Example:
+//
+//	session, loaded := m.GetOrSet(userID, newSession())
+//	if !loaded {
+//	    fmt.Println("created new session")
+//	}
+func (m *SyncOrderedHashMap[K, V]) GetOrSet(key K, value V) (actual V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.ohm.Get(key); ok {
+		return existing, true
+	}
+	m.ohm.Set(key, value)
+	return value, false
+}
+
+// SetIfAbsent stores value for key only if key is not already present.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to insert
+//   - value: the value to store
+//
+// Returns:
+//   - true if the pair was stored, false if key was already present
+func (m *SyncOrderedHashMap[K, V]) SetIfAbsent(key K, value V) bool {
+	_, loaded := m.GetOrSet(key, value)
+	return !loaded
+}
+
+// GetAndDelete removes key and returns the value it held.
+// The lookup and the removal happen atomically.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to remove
+//
+// Returns:
+//   - value: the removed value, or zero value if key was not present
+//   - loaded: true if key was present, false otherwise
+func (m *SyncOrderedHashMap[K, V]) GetAndDelete(key K) (value V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, loaded = m.ohm.Get(key)
+	if loaded {
+		m.ohm.Delete(key)
+	}
+	return value, loaded
+}
+
+// Delete removes the key-value pair with the given key.
+// Time complexity: O(1) average case.
+func (m *SyncOrderedHashMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ohm.Delete(key)
+}
+
+// MoveToBack moves the pair with the given key to the end of the order.
+// Time complexity: O(1) average case.
+func (m *SyncOrderedHashMap[K, V]) MoveToBack(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ohm.MoveToBack(key)
+}
+
+// MoveToFront moves the pair with the given key to the start of the order.
+// Time complexity: O(1) average case.
+func (m *SyncOrderedHashMap[K, V]) MoveToFront(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ohm.MoveToFront(key)
+}
+
+// First returns the first key-value pair in the order without removing it.
+// Time complexity: O(1).
+func (m *SyncOrderedHashMap[K, V]) First() (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ohm.First()
+}
+
+// Last returns the last key-value pair in the order without removing it.
+// Time complexity: O(1).
+func (m *SyncOrderedHashMap[K, V]) Last() (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ohm.Last()
+}
+
+// Size returns the number of key-value pairs in the map.
+// Time complexity: O(1).
+func (m *SyncOrderedHashMap[K, V]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ohm.Size()
+}
+
+// IsEmpty returns true if the map contains no key-value pairs.
+// Time complexity: O(1).
+func (m *SyncOrderedHashMap[K, V]) IsEmpty() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ohm.IsEmpty()
+}
+
+// Keys returns a slice containing all keys in insertion order.
+// Time complexity: O(n) where n is the number of key-value pairs.
+func (m *SyncOrderedHashMap[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ohm.Keys()
+}
+
+// Values returns a slice containing all values in insertion order.
+// Time complexity: O(n) where n is the number of key-value pairs.
+func (m *SyncOrderedHashMap[K, V]) Values() []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ohm.Values()
+}
+
+// ToSlice returns a slice containing all key-value pairs in insertion order.
+// Time complexity: O(n) where n is the number of key-value pairs.
+func (m *SyncOrderedHashMap[K, V]) ToSlice() []KVPair[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ohm.ToSlice()
+}
+
+// All returns an iterator over a snapshot of the key-value pairs in insertion order.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the map.
+// Time complexity: O(n) time and O(n) space.
+func (m *SyncOrderedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, pair := range m.ToSlice() {
+			if !yield(pair.Key, pair.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over a snapshot of the key-value pairs in reverse insertion order.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the map.
+// Time complexity: O(n) time and O(n) space.
+func (m *SyncOrderedHashMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		pairs := m.ToSlice()
+		for i := len(pairs) - 1; i >= 0; i-- {
+			if !yield(pairs[i].Key, pairs[i].Value) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns an iterator over a snapshot of the keys in insertion order.
+// Time complexity: O(n) time and O(n) space.
+func (m *SyncOrderedHashMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, key := range m.Keys() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over a snapshot of the values in insertion order.
+// Time complexity: O(n) time and O(n) space.
+func (m *SyncOrderedHashMap[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range m.Values() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler, encoding the map as a JSON object in insertion order.
+// A zero-value wrapper encodes as an empty object.
+// Time complexity: O(n).
+func (m *SyncOrderedHashMap[K, V]) MarshalJSON() ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.ohm == nil {
+		return New[K, V]().MarshalJSON()
+	}
+	return m.ohm.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of the map
+// with the members of a JSON object in document order.
+// Time complexity: O(n).
+func (m *SyncOrderedHashMap[K, V]) UnmarshalJSON(data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ohm == nil {
+		m.ohm = New[K, V]()
+	}
+	return m.ohm.UnmarshalJSON(data)
+}
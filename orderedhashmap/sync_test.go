@@ -0,0 +1,130 @@
+package orderedhashmap
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSyncOrderedHashMapOperations(t *testing.T) {
+	m := NewSync[string, int](nil)
+	if !m.IsEmpty() {
+		t.Error("Expected new map to be empty")
+	}
+
+	m.Set("a", 1)
+	if actual, loaded := m.GetOrSet("a", 100); !loaded || actual != 1 {
+		t.Errorf("Expected (1, true), got (%d, %t)", actual, loaded)
+	}
+	if actual, loaded := m.GetOrSet("b", 2); loaded || actual != 2 {
+		t.Errorf("Expected (2, false), got (%d, %t)", actual, loaded)
+	}
+	if m.SetIfAbsent("b", 200) {
+		t.Error("Expected SetIfAbsent to fail for existing key")
+	}
+	if !m.SetIfAbsent("c", 3) {
+		t.Error("Expected SetIfAbsent to succeed for new key")
+	}
+	if value, ok := m.Get("b"); !ok || value != 2 {
+		t.Errorf("Expected (2, true), got (%d, %t)", value, ok)
+	}
+
+	if !reflect.DeepEqual(m.Keys(), []string{"a", "b", "c"}) {
+		t.Errorf("Unexpected keys %v", m.Keys())
+	}
+	if !reflect.DeepEqual(m.Values(), []int{1, 2, 3}) {
+		t.Errorf("Unexpected values %v", m.Values())
+	}
+	if got := slices.Collect(m.KeysSeq()); !reflect.DeepEqual(got, m.Keys()) {
+		t.Errorf("Unexpected KeysSeq %v", got)
+	}
+	if got := slices.Collect(m.ValuesSeq()); !reflect.DeepEqual(got, m.Values()) {
+		t.Errorf("Unexpected ValuesSeq %v", got)
+	}
+	var backward []string
+	for k := range m.Backward() {
+		backward = append(backward, k)
+	}
+	if !reflect.DeepEqual(backward, []string{"c", "b", "a"}) {
+		t.Errorf("Unexpected Backward %v", backward)
+	}
+
+	if value, loaded := m.GetAndDelete("b"); !loaded || value != 2 {
+		t.Errorf("Expected (2, true), got (%d, %t)", value, loaded)
+	}
+	if _, loaded := m.GetAndDelete("b"); loaded {
+		t.Error("Expected second GetAndDelete to report missing key")
+	}
+
+	if !m.MoveToFront("c") || !m.MoveToBack("a") {
+		t.Error("Expected moves of existing keys to succeed")
+	}
+	if k, _, _ := m.First(); k != "c" {
+		t.Errorf("Expected first key c, got %s", k)
+	}
+	if k, _, _ := m.Last(); k != "a" {
+		t.Errorf("Expected last key a, got %s", k)
+	}
+
+	for k := range m.All() {
+		m.Delete(k) // modifying during iteration must not deadlock
+	}
+	if m.Size() != 0 || len(m.ToSlice()) != 0 {
+		t.Error("Expected map to be empty")
+	}
+}
+
+func TestSyncOrderedHashMapConcurrent(t *testing.T) {
+	const goroutines = 32
+	const keys = 200
+
+	m := NewSync(New[string, int]())
+	var stored atomic.Int64
+	var wg sync.WaitGroup
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < keys; i++ {
+				key := strconv.Itoa(i)
+				if _, loaded := m.GetOrSet(key, g); !loaded {
+					stored.Add(1)
+				}
+				m.Get(key)
+				if i%50 == 0 {
+					m.Keys()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if stored.Load() != keys {
+		t.Errorf("Expected exactly %d stores, got %d", keys, stored.Load())
+	}
+	if m.Size() != keys {
+		t.Errorf("Expected size %d, got %d", keys, m.Size())
+	}
+
+	var deleted atomic.Int64
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < keys; i++ {
+				if _, loaded := m.GetAndDelete(strconv.Itoa(i)); loaded {
+					deleted.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if deleted.Load() != keys || !m.IsEmpty() {
+		t.Errorf("Expected %d deletions and empty map, got %d and size %d", keys, deleted.Load(), m.Size())
+	}
+}
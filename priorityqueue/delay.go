@@ -0,0 +1,262 @@
+package priorityqueue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/thefrost13/gollections/clock"
+)
+
+// DelayQueue is a queue whose elements become available only once their deadline has passed.
+// Elements are kept in a Queue keyed by time.Time and leave in deadline order, with equal
+// deadlines leaving in insertion order. Take sleeps until the earliest deadline and wakes
+// early when a sooner element is enqueued. It is safe for concurrent use.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type DelayQueue[T any] struct {
+	mu    sync.Mutex           // guards every field below
+	queue *Queue[T, time.Time] // pending elements ordered by deadline
+	clock clock.TimerClock     // source of the current time and of timers
+	wake  chan struct{}        // closed when the earliest deadline changes, nil while no taker is waiting
+}
+
+// NewDelay creates and returns a new empty DelayQueue that uses the system clock.
+// Time complexity: O(1).
+//
+// Returns:
+//   - a new empty DelayQueue
+//
+// Example:
+//
+//	retries := NewDelay[Request]()
+//	retries.EnqueueAfter(req, 5*time.Second)
+//	req, err := retries.TakeContext(ctx)  // returns after about five seconds
+func NewDelay[T any]() *DelayQueue[T] {
+	return NewDelayWithClock[T](clock.System())
+}
+
+// NewDelayWithClock creates and returns a new empty DelayQueue that reads time and creates
+// timers from the given clock. If c is nil, the system clock is used.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - c: the clock that decides when elements are due, can be nil
+//
+// Returns:
+//   - a new empty DelayQueue
+//
+// Example:
+//
+//	fake := clock.NewFake(time.Now())
+//	retries := NewDelayWithClock[string](fake)
+//	retries.EnqueueAfter("job", time.Minute)
+//	fake.Advance(time.Minute)
+//	retries.TryDequeue()  // "job", true
+func NewDelayWithClock[T any](c clock.TimerClock) *DelayQueue[T] {
+	if c == nil {
+		c = clock.System()
+	}
+	return &DelayQueue[T]{
+		queue: NewLess[T](func(a, b time.Time) bool { return a.Before(b) }, WithStable()),
+		clock: c,
+	}
+}
+
+// Enqueue adds an element that becomes available at the given time.
+// If it is now the earliest element, waiting takers are woken to recompute their deadline.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - value: the element to add
+//   - at: the time from which the element can be taken
+//
+// Example:
+//
+//	dq.Enqueue("report", time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+func (dq *DelayQueue[T]) Enqueue(value T, at time.Time) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if item := dq.queue.push(value, at); dq.queue.items[0] == item {
+		dq.wakeTakers()
+	}
+}
+
+// EnqueueAfter adds an element that becomes available once delay has elapsed on the queue's clock.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - value: the element to add
+//   - delay: how long from now the element stays unavailable
+//
+// Example:
+//
+//	retries.EnqueueAfter(req, backoff)
+func (dq *DelayQueue[T]) EnqueueAfter(value T, delay time.Duration) {
+	dq.Enqueue(value, dq.clock.Now().Add(delay))
+}
+
+// Take removes and returns the earliest element, blocking until its deadline has passed.
+// It blocks indefinitely while the queue is empty; use TakeContext to give up.
+// Time complexity: O(log n) once an element is due.
+//
+// Returns:
+//   - the earliest element, once it is due
+//
+// Example:
+//
+//	go func() {
+//	    for {
+//	        retry(retries.Take())
+//	    }
+//	}()
+func (dq *DelayQueue[T]) Take() T {
+	value, _ := dq.TakeContext(context.Background())
+	return value
+}
+
+// TakeContext removes and returns the earliest element, blocking until its deadline has passed
+// or until ctx is cancelled or its deadline passes.
+// Time complexity: O(log n) once an element is due.
+//
+// Parameters:
+//   - ctx: controls how long to wait for an element to become due
+//
+// Returns:
+//   - value: the earliest due element, or zero value on error
+//   - err: nil on success, or ctx.Err() if ctx ends first
+//
+// Example:
+//
+//	for {
+//	    req, err := retries.TakeContext(ctx)
+//	    if err != nil {
+//	        return err  // shutting down
+//	    }
+//	    send(req)
+//	}
+func (dq *DelayQueue[T]) TakeContext(ctx context.Context) (T, error) {
+	for {
+		dq.mu.Lock()
+		var timer clock.Timer
+		var due <-chan time.Time
+		if dq.queue.Len() > 0 {
+			at := dq.queue.items[0].priority
+			if !at.After(dq.clock.Now()) {
+				value := dq.queue.Dequeue()
+				dq.mu.Unlock()
+				return value, nil
+			}
+			timer = dq.clock.NewTimerAt(at)
+			due = timer.C()
+		}
+		if dq.wake == nil {
+			dq.wake = make(chan struct{})
+		}
+		wait := dq.wake
+		dq.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-due:
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			var zero T
+			return zero, ctx.Err()
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// TryDequeue removes and returns the earliest element if its deadline has passed, without blocking.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Returns:
+//   - value: the earliest element, or zero value if none is due
+//   - ok: true if an element was removed, false otherwise
+func (dq *DelayQueue[T]) TryDequeue() (T, bool) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.queue.Len() == 0 || dq.queue.items[0].priority.After(dq.clock.Now()) {
+		var zero T
+		return zero, false
+	}
+	return dq.queue.Dequeue(), true
+}
+
+// DequeueExpired removes and returns every element whose deadline has passed, earliest first.
+// Time complexity: O(k log n) where k is the number of due elements.
+//
+// Returns:
+//   - the due elements, or an empty slice if none are due
+//
+// Example:
+//
+//	for _, req := range retries.DequeueExpired() {
+//	    send(req)
+//	}
+func (dq *DelayQueue[T]) DequeueExpired() []T {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	now := dq.clock.Now()
+	expired := []T{}
+	for dq.queue.Len() > 0 && !dq.queue.items[0].priority.After(now) {
+		expired = append(expired, dq.queue.Dequeue())
+	}
+	return expired
+}
+
+// TryPeek returns the earliest element and its deadline without removing it, whether or not it is due.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the earliest element, or zero value if the queue is empty
+//   - at: the element's deadline, or the zero time if the queue is empty
+//   - ok: true if the queue is not empty, false otherwise
+func (dq *DelayQueue[T]) TryPeek() (T, time.Time, bool) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.queue.Len() == 0 {
+		var zero T
+		return zero, time.Time{}, false
+	}
+	item := dq.queue.items[0]
+	return item.value, item.priority, true
+}
+
+// Size returns the number of elements in the queue, due or not.
+// Time complexity: O(1).
+func (dq *DelayQueue[T]) Size() int {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.queue.Len()
+}
+
+// IsEmpty returns true if the queue contains no elements.
+// Time complexity: O(1).
+func (dq *DelayQueue[T]) IsEmpty() bool {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.queue.Len() == 0
+}
+
+// Clear removes all elements from the queue.
+// Time complexity: O(n) where n is the number of elements.
+func (dq *DelayQueue[T]) Clear() {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	dq.queue.Clear()
+}
+
+// wakeTakers wakes every Take waiting for the earliest deadline. dq.mu must be held.
+func (dq *DelayQueue[T]) wakeTakers() {
+	if dq.wake != nil {
+		close(dq.wake)
+		dq.wake = nil
+	}
+}
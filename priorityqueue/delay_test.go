@@ -0,0 +1,176 @@
+package priorityqueue
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thefrost13/gollections/clock"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestDelayQueueOperations(t *testing.T) {
+	fake := clock.NewFake(epoch)
+	dq := NewDelayWithClock[string](fake)
+	if !dq.IsEmpty() || dq.Size() != 0 {
+		t.Error("Expected new queue to be empty")
+	}
+	if _, _, ok := dq.TryPeek(); ok {
+		t.Error("Expected TryPeek on empty queue to fail")
+	}
+
+	dq.EnqueueAfter("c", 3*time.Minute)
+	dq.EnqueueAfter("a", time.Minute)
+	dq.Enqueue("b", epoch.Add(2*time.Minute))
+	dq.Enqueue("b2", epoch.Add(2*time.Minute))
+	if dq.Size() != 4 {
+		t.Errorf("Expected size 4, got %d", dq.Size())
+	}
+	if value, at, ok := dq.TryPeek(); !ok || value != "a" || !at.Equal(epoch.Add(time.Minute)) {
+		t.Errorf("Expected (a, %v, true), got (%s, %v, %t)", epoch.Add(time.Minute), value, at, ok)
+	}
+
+	if value, ok := dq.TryDequeue(); ok {
+		t.Errorf("Expected no element to be due, got %s", value)
+	}
+	fake.Advance(time.Minute)
+	if value, ok := dq.TryDequeue(); !ok || value != "a" {
+		t.Errorf("Expected (a, true), got (%s, %t)", value, ok)
+	}
+
+	fake.Advance(time.Minute)
+	if expired := dq.DequeueExpired(); !reflect.DeepEqual(expired, []string{"b", "b2"}) {
+		t.Errorf("Expected [b b2] in insertion order, got %v", expired)
+	}
+	if expired := dq.DequeueExpired(); len(expired) != 0 {
+		t.Errorf("Expected no expired elements, got %v", expired)
+	}
+
+	dq.Clear()
+	if !dq.IsEmpty() {
+		t.Error("Expected queue to be empty after Clear")
+	}
+}
+
+func TestDelayQueueTake(t *testing.T) {
+	t.Run("sleeps until deadline", func(t *testing.T) {
+		fake := clock.NewFake(epoch)
+		dq := NewDelayWithClock[int](fake)
+		dq.EnqueueAfter(1, time.Minute)
+		result := make(chan int)
+		go func() {
+			result <- dq.Take()
+		}()
+
+		fake.BlockUntil(1)
+		fake.Advance(59 * time.Second)
+		select {
+		case <-result:
+			t.Fatal("Take returned before the deadline")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		fake.Advance(time.Second)
+		select {
+		case value := <-result:
+			if value != 1 {
+				t.Errorf("Expected 1, got %d", value)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Take did not return at the deadline")
+		}
+	})
+
+	t.Run("wakes early for sooner element", func(t *testing.T) {
+		fake := clock.NewFake(epoch)
+		dq := NewDelayWithClock[string](fake)
+		dq.EnqueueAfter("later", time.Hour)
+		result := make(chan string)
+		go func() {
+			result <- dq.Take()
+		}()
+
+		fake.BlockUntil(1)
+		dq.Enqueue("now", epoch)
+		select {
+		case value := <-result:
+			if value != "now" {
+				t.Errorf("Expected now, got %s", value)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Take did not wake for the sooner element")
+		}
+		if dq.Size() != 1 {
+			t.Errorf("Expected later element to remain, got size %d", dq.Size())
+		}
+	})
+
+	t.Run("waits on empty queue", func(t *testing.T) {
+		dq := NewDelayWithClock[int](clock.NewFake(epoch))
+		result := make(chan int)
+		go func() {
+			result <- dq.Take()
+		}()
+		time.Sleep(10 * time.Millisecond)
+		dq.Enqueue(7, epoch)
+		select {
+		case value := <-result:
+			if value != 7 {
+				t.Errorf("Expected 7, got %d", value)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Take did not wake after Enqueue")
+		}
+	})
+
+	t.Run("system clock", func(t *testing.T) {
+		dq := NewDelay[string]()
+		start := time.Now()
+		dq.EnqueueAfter("retry", 20*time.Millisecond)
+		if value := dq.Take(); value != "retry" {
+			t.Errorf("Expected retry, got %s", value)
+		}
+		if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+			t.Errorf("Expected Take to wait at least 20ms, waited %v", elapsed)
+		}
+	})
+
+	t.Run("nil clock uses system clock", func(t *testing.T) {
+		dq := NewDelayWithClock[int](nil)
+		dq.EnqueueAfter(1, -time.Second)
+		if value, ok := dq.TryDequeue(); !ok || value != 1 {
+			t.Errorf("Expected (1, true), got (%d, %t)", value, ok)
+		}
+	})
+}
+
+func TestDelayQueueContext(t *testing.T) {
+	t.Run("empty queue", func(t *testing.T) {
+		dq := NewDelayWithClock[int](clock.NewFake(epoch))
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+		if _, err := dq.TakeContext(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("pending element", func(t *testing.T) {
+		fake := clock.NewFake(epoch)
+		dq := NewDelayWithClock[int](fake)
+		dq.EnqueueAfter(1, time.Hour)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if _, err := dq.TakeContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+		if dq.Size() != 1 {
+			t.Errorf("Expected element to remain queued, got size %d", dq.Size())
+		}
+	})
+}
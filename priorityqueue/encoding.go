@@ -0,0 +1,219 @@
+package priorityqueue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+// errNoOrdering is returned when decoding into a queue that has no ordering: a Queue other than
+// PriorityQueue, or a FuncQueue, that was not created with a constructor.
+var errNoOrdering = errors.New("priorityqueue: cannot decode into a queue without an ordering; create it with a constructor first")
+
+// entry is the serialized form of one element of a Queue.
+type entry[T, P any] struct {
+	Value    T `json:"value"`    // the element
+	Priority P `json:"priority"` // its priority
+}
+
+// MarshalJSON implements json.Marshaler, encoding the queue as a JSON array of
+// {"value": ..., "priority": ...} objects listed in dequeue order.
+// The ordering function and options are not encoded; they belong to the receiving queue.
+// Time complexity: O(n log n) where n is the number of elements.
+//
+// Returns:
+//   - the JSON encoding of the queue
+//   - an error if a value or priority cannot be marshaled
+//
+// Example:
+//
+//	pq := New[string]()
+//	pq.Enqueue("b", 2)
+//	pq.Enqueue("a", 1)
+//	data, _ := json.Marshal(pq)  // [{"value":"a","priority":1},{"value":"b","priority":2}]
+func (pq *Queue[T, P]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pq.entries())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It replaces the contents of the queue with the value-priority pairs of a JSON array,
+// enqueuing them in array order so that a stable queue keeps the encoded order among equal priorities.
+// The queue keeps its own ordering and options, so it must have been created with a constructor;
+// the zero PriorityQueue is the exception and decodes as the int min-heap of New.
+// A JSON null leaves the queue unchanged, and if an error occurs the queue is left unchanged.
+// Handles obtained before decoding no longer identify any element.
+// Time complexity: O(n log n) where n is the number of array elements.
+//
+// Parameters:
+//   - data: the JSON encoding of an array of value-priority objects
+//
+// Returns:
+//   - an error if data is not a valid encoding or the queue has no ordering
+//
+// Example:
+//
+//	pq := NewOrdered[string, float64](WithMaxHeap())
+//	_ = json.Unmarshal(data, pq)
+func (pq *Queue[T, P]) UnmarshalJSON(data []byte) error {
+	if !pq.hasOrder() {
+		return errNoOrdering
+	}
+	if string(data) == "null" {
+		return nil
+	}
+	var entries []entry[T, P]
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	pq.replace(entries)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using encoding/gob.
+// encoding/gob uses it as well, so a Queue can be sent with a gob.Encoder directly.
+// Elements are encoded with their priorities in dequeue order, matching MarshalJSON.
+// Time complexity: O(n log n) where n is the number of elements.
+//
+// Returns:
+//   - the binary encoding of the queue
+//   - an error if a value or priority cannot be encoded by gob
+func (pq *Queue[T, P]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(pq.entries()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the queue
+// with the value-priority pairs decoded from data. The queue keeps its own ordering and options,
+// so it must have been created with a constructor; the zero PriorityQueue decodes as the int min-heap of New.
+// If an error occurs the queue is left unchanged.
+// Time complexity: O(n log n) where n is the number of encoded elements.
+//
+// Parameters:
+//   - data: the output of MarshalBinary
+//
+// Returns:
+//   - an error if data is not a valid encoding or the queue has no ordering
+func (pq *Queue[T, P]) UnmarshalBinary(data []byte) error {
+	if !pq.hasOrder() {
+		return errNoOrdering
+	}
+	var entries []entry[T, P]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&entries); err != nil {
+		return err
+	}
+	pq.replace(entries)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the queue as a JSON array of its elements
+// in dequeue order. Unlike Queue, no priorities are written since the elements define their own order.
+// Time complexity: O(n log n) where n is the number of elements.
+func (fq *FuncQueue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fq.values())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of the queue with the
+// elements of a JSON array. The queue must have been created with NewFunc.
+// A JSON null leaves the queue unchanged, and if an error occurs the queue is left unchanged.
+// Time complexity: O(n log n) where n is the number of array elements.
+func (fq *FuncQueue[T]) UnmarshalJSON(data []byte) error {
+	if fq.Queue == nil || fq.less == nil {
+		return errNoOrdering
+	}
+	if string(data) == "null" {
+		return nil
+	}
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	fq.replaceValues(values)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using encoding/gob,
+// encoding the elements in dequeue order without priorities.
+// Time complexity: O(n log n) where n is the number of elements.
+func (fq *FuncQueue[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(fq.values()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the queue
+// with the elements decoded from data. The queue must have been created with NewFunc.
+// If an error occurs the queue is left unchanged.
+// Time complexity: O(n log n) where n is the number of encoded elements.
+func (fq *FuncQueue[T]) UnmarshalBinary(data []byte) error {
+	if fq.Queue == nil || fq.less == nil {
+		return errNoOrdering
+	}
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	fq.replaceValues(values)
+	return nil
+}
+
+// sortedItems returns the items in the order they would be dequeued, without modifying the queue.
+func (pq *Queue[T, P]) sortedItems() []*priorityQueueItem[T, P] {
+	items := slices.Clone(pq.items)
+	if len(items) == 0 {
+		return items
+	}
+	less := pq.order()
+	slices.SortFunc(items, func(a, b *priorityQueueItem[T, P]) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		}
+		return 0
+	})
+	return items
+}
+
+// entries returns the value-priority pairs in dequeue order.
+func (pq *Queue[T, P]) entries() []entry[T, P] {
+	items := pq.sortedItems()
+	entries := make([]entry[T, P], len(items))
+	for i, item := range items {
+		entries[i] = entry[T, P]{Value: item.value, Priority: item.priority}
+	}
+	return entries
+}
+
+// replace clears the queue and enqueues entries in order.
+func (pq *Queue[T, P]) replace(entries []entry[T, P]) {
+	pq.Clear()
+	for _, e := range entries {
+		pq.push(e.Value, e.Priority)
+	}
+}
+
+// values returns the elements of a FuncQueue in dequeue order.
+func (fq *FuncQueue[T]) values() []T {
+	items := fq.sortedItems()
+	values := make([]T, len(items))
+	for i, item := range items {
+		values[i] = item.value
+	}
+	return values
+}
+
+// replaceValues clears a FuncQueue and enqueues values in order.
+func (fq *FuncQueue[T]) replaceValues(values []T) {
+	fq.Clear()
+	for _, v := range values {
+		fq.push(v, struct{}{})
+	}
+}
@@ -0,0 +1,390 @@
+package priorityqueue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+// drain dequeues every element of pq in priority order.
+func drain[T, P any](pq *Queue[T, P]) []T {
+	var values []T
+	for !pq.IsEmpty() {
+		values = append(values, pq.Dequeue())
+	}
+	return values
+}
+
+func TestQueueJSON(t *testing.T) {
+	t.Run("Marshal value-priority pairs in dequeue order", func(t *testing.T) {
+		pq := New[string]()
+		pq.Enqueue("c", 3)
+		pq.Enqueue("a", 1)
+		pq.Enqueue("b", 2)
+		data, err := json.Marshal(pq)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := `[{"value":"a","priority":1},{"value":"b","priority":2},{"value":"c","priority":3}]`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+		if pq.Size() != 3 || pq.Peek() != "a" {
+			t.Error("Expected marshaling not to modify the queue")
+		}
+	})
+
+	t.Run("Unmarshal keeps the receiver's ordering", func(t *testing.T) {
+		data := []byte(`[{"value":"a","priority":1},{"value":"c","priority":3},{"value":"b","priority":2}]`)
+		pq := New[string](WithMaxHeap())
+		pq.Enqueue("old", 100)
+		if err := json.Unmarshal(data, pq); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := drain(pq); !reflect.DeepEqual(got, []string{"c", "b", "a"}) {
+			t.Errorf("Expected max-heap order [c b a], got %v", got)
+		}
+	})
+
+	t.Run("Stable queues keep FIFO order among equal priorities", func(t *testing.T) {
+		pq := New[int](WithStable())
+		for i := 0; i < 20; i++ {
+			pq.Enqueue(i, i%3)
+		}
+		data, err := json.Marshal(pq)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		decoded := New[int](WithStable())
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got, expected := drain(decoded), drain(pq); !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("Generic priority types", func(t *testing.T) {
+		base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		pq := NewLess[string](time.Time.Before)
+		pq.Enqueue("later", base.Add(time.Hour))
+		pq.Enqueue("sooner", base)
+		data, err := json.Marshal(pq)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		decoded := NewLess[string](time.Time.Before)
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := drain(decoded); !reflect.DeepEqual(got, []string{"sooner", "later"}) {
+			t.Errorf("Expected [sooner later], got %v", got)
+		}
+	})
+
+	t.Run("Handles from before decoding are invalidated", func(t *testing.T) {
+		pq := New[string]()
+		h := pq.EnqueueHandle("x", 1)
+		if err := json.Unmarshal([]byte(`[{"value":"y","priority":2}]`), pq); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if pq.Contains(h) {
+			t.Error("Expected old handle to be invalid")
+		}
+	})
+
+	t.Run("Zero PriorityQueue decodes as a min-heap", func(t *testing.T) {
+		var pq PriorityQueue[string]
+		if err := json.Unmarshal([]byte(`[{"value":"b","priority":2},{"value":"a","priority":1}]`), &pq); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := drain(&pq); !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Errorf("Expected [a b], got %v", got)
+		}
+	})
+
+	t.Run("Decode into struct fields", func(t *testing.T) {
+		type jobs struct {
+			Pending PriorityQueue[string]  `json:"pending"`
+			Retry   *PriorityQueue[string] `json:"retry"`
+		}
+		src := jobs{Retry: New[string]()}
+		src.Pending.Enqueue("low", 5)
+		src.Pending.Enqueue("high", 1)
+		src.Retry.Enqueue("again", 3)
+		data, err := json.Marshal(&src)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var dst jobs
+		if err := json.Unmarshal(data, &dst); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := drain(&dst.Pending); !reflect.DeepEqual(got, []string{"high", "low"}) {
+			t.Errorf("Expected [high low], got %v", got)
+		}
+		if dst.Retry == nil {
+			t.Fatal("Expected pointer field to be allocated")
+		}
+		if got := drain(dst.Retry); !reflect.DeepEqual(got, []string{"again"}) {
+			t.Errorf("Expected [again], got %v", got)
+		}
+	})
+
+	t.Run("Zero Queue without a default ordering cannot be decoded into", func(t *testing.T) {
+		var pq Queue[string, string]
+		if err := json.Unmarshal([]byte(`[]`), &pq); !errors.Is(err, errNoOrdering) {
+			t.Errorf("Expected errNoOrdering, got %v", err)
+		}
+		if err := pq.UnmarshalBinary(nil); !errors.Is(err, errNoOrdering) {
+			t.Errorf("Expected errNoOrdering, got %v", err)
+		}
+	})
+
+	t.Run("null and errors leave the queue unchanged", func(t *testing.T) {
+		pq := New[string]()
+		pq.Enqueue("keep", 1)
+		if err := pq.UnmarshalJSON([]byte("null")); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if err := json.Unmarshal([]byte(`[{"value":1,"priority":"x"}]`), pq); err == nil {
+			t.Error("Expected error for wrong field types")
+		}
+		if pq.Size() != 1 || pq.Peek() != "keep" {
+			t.Errorf("Expected queue to be unchanged, got %v", pq.ToSlice())
+		}
+	})
+}
+
+func TestQueueBinary(t *testing.T) {
+	t.Run("gob encoder uses the binary encoding", func(t *testing.T) {
+		pq := NewOrdered[string, float64]()
+		pq.Enqueue("b", 2.5)
+		pq.Enqueue("a", -1.25)
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(pq); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		decoded := NewOrdered[string, float64]()
+		if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := drain(decoded); !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Errorf("Expected [a b], got %v", got)
+		}
+	})
+
+	t.Run("gob decodes into struct fields", func(t *testing.T) {
+		type jobs struct {
+			Pending PriorityQueue[string]
+			Retry   *PriorityQueue[string]
+		}
+		src := jobs{Retry: New[string]()}
+		src.Pending.Enqueue("low", 5)
+		src.Pending.Enqueue("high", 1)
+		src.Retry.Enqueue("again", 3)
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(&src); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var dst jobs
+		if err := gob.NewDecoder(&buf).Decode(&dst); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := drain(&dst.Pending); !reflect.DeepEqual(got, []string{"high", "low"}) {
+			t.Errorf("Expected [high low], got %v", got)
+		}
+		if dst.Retry == nil {
+			t.Fatal("Expected pointer field to be allocated")
+		}
+		if got := drain(dst.Retry); !reflect.DeepEqual(got, []string{"again"}) {
+			t.Errorf("Expected [again], got %v", got)
+		}
+	})
+
+	t.Run("invalid data leaves the queue unchanged", func(t *testing.T) {
+		pq := New[int]()
+		pq.Enqueue(1, 1)
+		if err := pq.UnmarshalBinary([]byte("junk")); err == nil {
+			t.Error("Expected error for invalid data")
+		}
+		if pq.Size() != 1 {
+			t.Errorf("Expected size 1, got %d", pq.Size())
+		}
+	})
+}
+
+func TestFuncQueueEncoding(t *testing.T) {
+	less := func(a, b int) bool { return a > b }
+	fq := NewFunc(less)
+	for _, v := range []int{3, 9, 1} {
+		fq.Enqueue(v)
+	}
+
+	data, err := json.Marshal(fq)
+	if err != nil || string(data) != "[9,3,1]" {
+		t.Errorf("Expected [9,3,1], got %s (%v)", data, err)
+	}
+	fromJSON := NewFunc(less)
+	if err := json.Unmarshal([]byte(`[4,8,6]`), fromJSON); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := drain(fromJSON.Queue); !reflect.DeepEqual(got, []int{8, 6, 4}) {
+		t.Errorf("Expected [8 6 4], got %v", got)
+	}
+
+	data, err = fq.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromBinary := NewFunc(less)
+	if err := fromBinary.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := drain(fromBinary.Queue); !reflect.DeepEqual(got, []int{9, 3, 1}) {
+		t.Errorf("Expected [9 3 1], got %v", got)
+	}
+
+	var zero FuncQueue[int]
+	if err := zero.UnmarshalJSON([]byte(`[1]`)); !errors.Is(err, errNoOrdering) {
+		t.Errorf("Expected errNoOrdering, got %v", err)
+	}
+}
+
+func TestSyncQueueEncoding(t *testing.T) {
+	pq := NewSync(New[string]())
+	pq.Enqueue("b", 2)
+	pq.Enqueue("a", 1)
+	data, err := json.Marshal(pq)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded := NewSync(New[string]())
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Peek() != "a" || decoded.Size() != 2 {
+		t.Errorf("Unexpected decoded queue %v", decoded.ToSlice())
+	}
+
+	data, err = pq.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fromBinary := NewSync(New[string]())
+	if err := fromBinary.UnmarshalBinary(data); err != nil || fromBinary.Peek() != "a" {
+		t.Errorf("Expected a at the front, got %s (%v)", fromBinary.Peek(), err)
+	}
+}
+
+func TestSyncQueueZeroValueEncoding(t *testing.T) {
+	t.Run("SyncPriorityQueue", func(t *testing.T) {
+		var zero SyncPriorityQueue[string]
+		data, err := json.Marshal(&zero)
+		if err != nil || string(data) != "[]" {
+			t.Errorf("Expected [], got %s (%v)", data, err)
+		}
+		if _, err := zero.MarshalBinary(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		var decoded SyncPriorityQueue[string]
+		if err := json.Unmarshal([]byte(`[{"value":"b","priority":2},{"value":"a","priority":1}]`), &decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if decoded.Peek() != "a" || decoded.Size() != 2 {
+			t.Errorf("Expected a at the front of 2 elements, got %v", decoded.ToSlice())
+		}
+
+		data, err = decoded.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var fromBinary SyncPriorityQueue[string]
+		if err := fromBinary.UnmarshalBinary(data); err != nil || fromBinary.Peek() != "a" {
+			t.Errorf("Expected a at the front, got %s (%v)", fromBinary.Peek(), err)
+		}
+	})
+
+	t.Run("no default ordering", func(t *testing.T) {
+		var zero SyncQueue[string, string]
+		if data, err := json.Marshal(&zero); err != nil || string(data) != "[]" {
+			t.Errorf("Expected [], got %s (%v)", data, err)
+		}
+		if err := json.Unmarshal([]byte(`[]`), &zero); !errors.Is(err, errNoOrdering) {
+			t.Errorf("Expected errNoOrdering, got %v", err)
+		}
+		if err := zero.UnmarshalBinary(nil); !errors.Is(err, errNoOrdering) {
+			t.Errorf("Expected errNoOrdering, got %v", err)
+		}
+	})
+}
+
+func FuzzQueueRoundTrip(f *testing.F) {
+	f.Add([]byte{}, false)
+	f.Add([]byte{5, 1, 5, 3, 1}, true)
+	f.Add([]byte("many equal priorities: aaaaaaaa"), true)
+
+	f.Fuzz(func(t *testing.T, data []byte, stable bool) {
+		var opts []Option
+		if stable {
+			opts = append(opts, WithStable())
+		}
+		newQueue := func() *Queue[int, int8] { return NewOrdered[int, int8](opts...) }
+
+		// Priorities come from the low bits only, so equal priorities are common.
+		pq, reference := newQueue(), newQueue()
+		for i, b := range data {
+			pq.Enqueue(i, int8(b%4))
+			reference.Enqueue(i, int8(b%4))
+		}
+		expected := drain(reference)
+
+		encoded, err := pq.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON: %v", err)
+		}
+		fromJSON := newQueue()
+		if err := json.Unmarshal(encoded, fromJSON); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", encoded, err)
+		}
+
+		encoded, err = pq.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary: %v", err)
+		}
+		fromBinary := newQueue()
+		if err := fromBinary.UnmarshalBinary(encoded); err != nil {
+			t.Fatalf("UnmarshalBinary: %v", err)
+		}
+
+		for name, decoded := range map[string]*Queue[int, int8]{"JSON": fromJSON, "Binary": fromBinary} {
+			if decoded.Size() != len(expected) {
+				t.Fatalf("%s round trip: expected size %d, got %d", name, len(expected), decoded.Size())
+			}
+			got := drain(decoded)
+			if !stable {
+				// Without stable mode only the set of elements is guaranteed to survive.
+				got, expected := slices.Sorted(slices.Values(got)), slices.Sorted(slices.Values(expected))
+				if !reflect.DeepEqual(got, expected) {
+					t.Errorf("%s round trip: expected elements %v, got %v", name, expected, got)
+				}
+				continue
+			}
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("%s round trip: expected %v, got %v", name, expected, got)
+			}
+		}
+
+		// Arbitrary input must never panic.
+		_ = newQueue().UnmarshalJSON(data)
+		_ = newQueue().UnmarshalBinary(data)
+	})
+}
@@ -0,0 +1,103 @@
+package priorityqueue
+
+import "container/heap"
+
+// FuncQueue is a priority queue whose elements define their own ordering.
+// Instead of pairing each value with a separate priority, the queue compares values directly
+// with the function given to NewFunc, which suits elements that already carry their deadline,
+// weight or composite sort key.
+// All methods of Queue other than Enqueue are available and behave the same way.
+// The zero value is not usable; use NewFunc.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type FuncQueue[T any] struct {
+	*Queue[T, struct{}]
+}
+
+// NewFunc creates and returns a new empty FuncQueue ordered by less.
+// less must describe a strict weak ordering and should report whether a should be dequeued before b.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - less: reports whether element a should be dequeued before element b
+//   - opts: optional settings such as WithMaxHeap and WithStable
+//
+// Returns:
+//   - a new empty FuncQueue
+//
+// Example:
+//
+//	type Job struct {
+//	    Name     string
+//	    Deadline time.Time
+//	}
+//	pq := NewFunc(func(a, b Job) bool { return a.Deadline.Before(b.Deadline) })
+//	pq.Enqueue(Job{Name: "report", Deadline: tomorrow})
+func NewFunc[T any](less func(a, b T) bool, opts ...Option) *FuncQueue[T] {
+	return &FuncQueue[T]{
+		Queue: newQueue(func(a, b *priorityQueueItem[T, struct{}]) bool {
+			return less(a.value, b.value)
+		}, opts),
+	}
+}
+
+// Enqueue adds an element to the queue, positioned according to the queue's ordering function.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - value: the element to add to the queue
+//
+// Example:
+//
+//	pq := NewFunc(func(a, b int) bool { return a > b })
+//	pq.Enqueue(3)
+//	pq.Enqueue(7)
+//	pq.Dequeue()  // 7
+func (fq *FuncQueue[T]) Enqueue(value T) {
+	fq.Queue.Enqueue(value, struct{}{})
+}
+
+// EnqueueHandle adds an element to the queue and returns a handle to it.
+// The handle can be passed to Update, Remove and Contains while the element is in the queue.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - value: the element to add to the queue
+//
+// Returns:
+//   - a handle identifying the enqueued element
+//
+// Example:
+//
+//	h := pq.EnqueueHandle(job)
+//	pq.Remove(h)  // Cancel the job
+func (fq *FuncQueue[T]) EnqueueHandle(value T) Handle[T, struct{}] {
+	return fq.Queue.EnqueueHandle(value, struct{}{})
+}
+
+// Update replaces the element identified by h and restores heap order,
+// which is how an element's ordering key is changed in a FuncQueue.
+// It returns false without changing anything if the element is no longer in the queue.
+// Update changes what h.Value returns, so the two must not run concurrently without a lock.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - h: the handle returned by EnqueueHandle
+//   - value: the replacement element
+//
+// Returns:
+//   - true if the element was updated, false if it is not in the queue
+//
+// Example:
+//
+//	job.Deadline = job.Deadline.Add(-time.Hour)
+//	pq.Update(h, job)  // Move the job earlier
+func (fq *FuncQueue[T]) Update(h Handle[T, struct{}], value T) bool {
+	if !fq.Contains(h) {
+		return false
+	}
+	h.item.value = value
+	heap.Fix(fq.Queue, h.item.index)
+	return true
+}
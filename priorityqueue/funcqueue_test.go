@@ -0,0 +1,112 @@
+package priorityqueue
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewFunc(t *testing.T) {
+	t.Run("elements define ordering", func(t *testing.T) {
+		type job struct {
+			name     string
+			deadline int
+		}
+		pq := NewFunc(func(a, b job) bool { return a.deadline < b.deadline })
+		pq.Enqueue(job{name: "later", deadline: 30})
+		pq.Enqueue(job{name: "soon", deadline: 10})
+		pq.Enqueue(job{name: "middle", deadline: 20})
+
+		if pq.Size() != 3 {
+			t.Errorf("Expected size 3, got %d", pq.Size())
+		}
+		if pq.Peek().name != "soon" {
+			t.Errorf("Expected peek 'soon', got '%s'", pq.Peek().name)
+		}
+
+		var names []string
+		for !pq.IsEmpty() {
+			names = append(names, pq.Dequeue().name)
+		}
+		expected := []string{"soon", "middle", "later"}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("Expected %v, got %v", expected, names)
+		}
+	})
+
+	t.Run("max heap reverses ordering", func(t *testing.T) {
+		pq := NewFunc(func(a, b string) bool { return strings.ToLower(a) < strings.ToLower(b) }, WithMaxHeap())
+		for _, word := range []string{"banana", "Apple", "cherry"} {
+			pq.Enqueue(word)
+		}
+
+		var words []string
+		for !pq.IsEmpty() {
+			words = append(words, pq.Dequeue())
+		}
+		expected := []string{"cherry", "banana", "Apple"}
+		if !reflect.DeepEqual(words, expected) {
+			t.Errorf("Expected %v, got %v", expected, words)
+		}
+	})
+
+	t.Run("empty queue", func(t *testing.T) {
+		pq := NewFunc(func(a, b int) bool { return a < b })
+		if _, ok := pq.TryDequeue(); ok {
+			t.Error("Expected TryDequeue to report empty queue")
+		}
+		if pq.Dequeue() != 0 {
+			t.Error("Expected zero value from empty queue")
+		}
+	})
+}
+
+func TestNewFuncStable(t *testing.T) {
+	type task struct {
+		name   string
+		weight int
+	}
+	pq := NewFunc(func(a, b task) bool { return a.weight < b.weight }, WithStable())
+	for i, name := range []string{"a", "b", "c", "d"} {
+		pq.Enqueue(task{name: name, weight: i % 2})
+	}
+
+	var names []string
+	for !pq.IsEmpty() {
+		names = append(names, pq.Dequeue().name)
+	}
+	expected := []string{"a", "c", "b", "d"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+}
+
+func TestFuncQueueHandles(t *testing.T) {
+	type timer struct {
+		name string
+		at   int
+	}
+	pq := NewFunc(func(a, b timer) bool { return a.at < b.at })
+	a := pq.EnqueueHandle(timer{name: "a", at: 10})
+	b := pq.EnqueueHandle(timer{name: "b", at: 20})
+	pq.Enqueue(timer{name: "c", at: 30})
+
+	if !pq.Update(b, timer{name: "b", at: 5}) {
+		t.Fatal("Expected Update to succeed")
+	}
+	if _, ok := pq.Remove(a); !ok {
+		t.Fatal("Expected Remove to succeed")
+	}
+	if pq.Update(a, timer{name: "a", at: 1}) {
+		t.Error("Expected Update on removed handle to fail")
+	}
+
+	var names []string
+	for !pq.IsEmpty() {
+		names = append(names, pq.Dequeue().name)
+	}
+	expected := []string{"b", "c"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+}
@@ -1,59 +1,232 @@
 // Package priorityqueue provides a generic priority queue implementation using Go's container/heap.
-// Elements are dequeued in priority order, with lower priority values having higher precedence.
+// By default elements are dequeued in priority order, with lower priority values having higher precedence.
+// Queues can also be ordered by any cmp.Ordered priority type, by a custom comparison function,
+// or in max-first order.
 package priorityqueue
 
-import "container/heap"
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"iter"
+
+	"github.com/thefrost13/gollections/errs"
+)
 
 // priorityQueueItem represents an item in the priority queue with its associated priority.
-// Lower priority values indicate higher precedence.
-type priorityQueueItem[T any] struct {
-	value    T   // the actual value stored in the queue
-	priority int // the priority of the item (lower = higher precedence)
+type priorityQueueItem[T, P any] struct {
+	value    T      // the actual value stored in the queue
+	priority P      // the priority of the item
+	seq      uint64 // insertion sequence number, used to break ties in stable mode
+	index    int    // position in the heap, or -1 once the item has left the queue
 }
 
-// PriorityQueue is a generic priority queue that dequeues elements in priority order.
+// Queue is a generic priority queue that dequeues elements in priority order.
 // It's implemented using Go's container/heap package for efficient operations.
-// Lower priority values have higher precedence (min-heap behavior).
+// The ordering of priorities is fixed by the constructor: NewOrdered orders any cmp.Ordered
+// priority type, NewLess accepts a custom comparison, and WithMaxHeap reverses either.
+// The zero value of PriorityQueue is an empty min-heap ready to use, like New[T]() without options.
+// Zero values of other Queue types have no ordering; use one of the constructors.
 //
 // Type parameters:
 //   - T: the element type, can be any type
-type PriorityQueue[T any] []priorityQueueItem[T]
+//   - P: the priority type, can be any type that the queue knows how to compare
+type Queue[T, P any] struct {
+	items   []*priorityQueueItem[T, P]               // heap-ordered items
+	less    func(a, b *priorityQueueItem[T, P]) bool // reports whether a should be dequeued before b
+	nextSeq uint64                                   // sequence number assigned to the next enqueued item
+}
+
+// Handle identifies an element enqueued with EnqueueHandle.
+// It tracks the element's position in the heap so the element can be updated or removed
+// without searching. The zero Handle does not identify any element.
+// A Handle does no locking of its own: Priority reads state that UpdatePriority changes,
+// so for a SyncQueue read the priority with SyncQueue.Priority instead, and Value reads
+// state that FuncQueue.Update changes.
+//
+// Type parameters:
+//   - T: the element type of the queue
+//   - P: the priority type of the queue
+type Handle[T, P any] struct {
+	item *priorityQueueItem[T, P] // the tracked item, nil for the zero Handle
+}
+
+// Value returns the element identified by the handle.
+// It remains available after the element has left the queue. Only FuncQueue.Update changes
+// the element, so Value is safe to call concurrently with operations on the queue as long as
+// nothing calls Update on this handle; otherwise read it under the lock that guards Update,
+// as SyncQueue.Priority does for priorities.
+//
+// Returns:
+//   - the element the handle was created for, or zero value for the zero Handle
+func (h Handle[T, P]) Value() T {
+	if h.item == nil {
+		var zero T
+		return zero
+	}
+	return h.item.value
+}
+
+// Priority returns the current priority of the element identified by the handle.
+// It is not synchronized with UpdatePriority; use SyncQueue.Priority when the queue is shared.
+//
+// Returns:
+//   - the element's priority, or zero value for the zero Handle
+func (h Handle[T, P]) Priority() P {
+	if h.item == nil {
+		var zero P
+		return zero
+	}
+	return h.item.priority
+}
+
+// PriorityQueue is a generic priority queue with int priorities.
+// Lower priority values have higher precedence (min-heap behavior) unless WithMaxHeap is used.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type PriorityQueue[T any] = Queue[T, int]
+
+// Option configures the ordering behavior of a queue at construction time.
+type Option func(*options)
+
+// options holds the settings collected from Option values.
+type options struct {
+	maxHeap bool // dequeue the greatest priority first
+	stable  bool // dequeue equal priorities in insertion order
+}
+
+// WithMaxHeap makes the queue dequeue the element with the greatest priority first.
+//
+// Example:
+//
+//	pq := New[string](WithMaxHeap())
+//	pq.Enqueue("low", 1)
+//	pq.Enqueue("high", 10)
+//	pq.Dequeue()  // "high"
+func WithMaxHeap() Option {
+	return func(o *options) {
+		o.maxHeap = true
+	}
+}
+
+// WithStable makes the queue dequeue elements of equal priority in the order they were enqueued.
+// Without it, container/heap gives no ordering guarantee among equal priorities.
+// Each element records an insertion sequence number that is compared only when priorities tie.
+//
+// Example:
+//
+//	pq := New[string](WithStable())
+//	pq.Enqueue("first", 1)
+//	pq.Enqueue("second", 1)
+//	pq.Dequeue()  // "first"
+func WithStable() Option {
+	return func(o *options) {
+		o.stable = true
+	}
+}
+
+// newQueue builds a queue whose items are ordered by less after applying opts.
+func newQueue[T, P any](less func(a, b *priorityQueueItem[T, P]) bool, opts []Option) *Queue[T, P] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.maxHeap {
+		base := less
+		less = func(a, b *priorityQueueItem[T, P]) bool { return base(b, a) }
+	}
+	if o.stable {
+		ordered := less
+		less = func(a, b *priorityQueueItem[T, P]) bool {
+			if ordered(a, b) {
+				return true
+			}
+			if ordered(b, a) {
+				return false
+			}
+			return a.seq < b.seq
+		}
+	}
+	return &Queue[T, P]{less: less}
+}
 
 // Len returns the number of elements in the priority queue.
 // This method is required by the heap.Interface.
-func (pq PriorityQueue[T]) Len() int { return len(pq) }
+func (pq *Queue[T, P]) Len() int { return len(pq.items) }
 
 // Less compares two elements by their priority.
 // Returns true if element i has higher priority than element j.
 // This method is required by the heap.Interface.
-func (pq PriorityQueue[T]) Less(i, j int) bool { return pq[i].priority < pq[j].priority }
+func (pq *Queue[T, P]) Less(i, j int) bool { return pq.order()(pq.items[i], pq.items[j]) }
+
+// order returns the function that orders the queue's items.
+// A queue that was not created with a constructor has no ordering function of its own:
+// a PriorityQueue then falls back to the int min-heap order of New, and any other Queue panics.
+func (pq *Queue[T, P]) order() func(a, b *priorityQueueItem[T, P]) bool {
+	if pq.less != nil {
+		return pq.less
+	}
+	if less := defaultOrder[T, P](); less != nil {
+		return less
+	}
+	panic("priorityqueue: Queue has no ordering; create it with New, NewOrdered or NewLess")
+}
+
+// hasOrder reports whether order would return an ordering instead of panicking.
+func (pq *Queue[T, P]) hasOrder() bool {
+	return pq.less != nil || defaultOrder[T, P]() != nil
+}
+
+// defaultOrder returns the int min-heap order if P is int, which makes the zero PriorityQueue
+// usable, and nil for every other priority type.
+func defaultOrder[T, P any]() func(a, b *priorityQueueItem[T, P]) bool {
+	var zero P
+	if _, ok := any(zero).(int); !ok {
+		return nil
+	}
+	return func(a, b *priorityQueueItem[T, P]) bool {
+		return any(a.priority).(int) < any(b.priority).(int)
+	}
+}
 
 // Swap exchanges the elements at positions i and j.
 // This method is required by the heap.Interface.
-func (pq PriorityQueue[T]) Swap(i, j int) { pq[i], pq[j] = pq[j], pq[i] }
+func (pq *Queue[T, P]) Swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
+}
 
 // Push adds an element to the priority queue.
 // This method is required by the heap.Interface and should not be called directly.
 // Use Enqueue instead.
-func (pq *PriorityQueue[T]) Push(x any) {
-	*pq = append(*pq, x.(priorityQueueItem[T]))
+func (pq *Queue[T, P]) Push(x any) {
+	item := x.(*priorityQueueItem[T, P])
+	item.index = len(pq.items)
+	pq.items = append(pq.items, item)
 }
 
 // Pop removes and returns the element with the highest priority.
 // This method is required by the heap.Interface and should not be called directly.
 // Use Dequeue instead.
-func (pq *PriorityQueue[T]) Pop() any {
-	old := *pq
+func (pq *Queue[T, P]) Pop() any {
+	old := pq.items
 	n := len(old)
 	last := old[n-1]
-	*pq = old[0 : n-1]
+	old[n-1] = nil
+	last.index = -1
+	pq.items = old[0 : n-1]
 	return last
 }
 
-// New creates and returns a new empty PriorityQueue.
+// New creates and returns a new empty PriorityQueue with int priorities.
 // The returned queue is ready to use and will maintain elements in priority order.
 // Time complexity: O(1).
 //
+// Parameters:
+//   - opts: optional settings such as WithMaxHeap and WithStable
+//
 // Returns:
 //   - a new empty PriorityQueue
 //
@@ -62,31 +235,197 @@ func (pq *PriorityQueue[T]) Pop() any {
 //	pq := New[string]()
 //	pq.Enqueue("low priority", 10)
 //	pq.Enqueue("high priority", 1)
-func New[T any]() *PriorityQueue[T] {
-	pq := &PriorityQueue[T]{}
+func New[T any](opts ...Option) *PriorityQueue[T] {
+	return NewOrdered[T, int](opts...)
+}
+
+// NewOrdered creates and returns a new empty Queue whose priorities can be any ordered type,
+// such as float64 deadlines or string keys.
+// Lower priority values have higher precedence unless WithMaxHeap is used.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - opts: optional settings such as WithMaxHeap and WithStable
+//
+// Returns:
+//   - a new empty Queue ordered by cmp.Less on the priorities
+//
+// Example:
+//
+//	pq := NewOrdered[string, float64]()
+//	pq.Enqueue("later", 2.5)
+//	pq.Enqueue("sooner", 0.75)
+func NewOrdered[T any, P cmp.Ordered](opts ...Option) *Queue[T, P] {
+	return newQueue(func(a, b *priorityQueueItem[T, P]) bool {
+		return cmp.Less(a.priority, b.priority)
+	}, opts)
+}
+
+// NewLess creates and returns a new empty Queue whose priorities are ordered by less.
+// This supports priority types that are not cmp.Ordered, such as time.Time or composite structs.
+// less must describe a strict weak ordering and should report whether a comes before b.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - less: reports whether priority a should be dequeued before priority b
+//   - opts: optional settings such as WithMaxHeap and WithStable
+//
+// Returns:
+//   - a new empty Queue ordered by less
+//
+// Example:
+//
+//	pq := NewLess[string](func(a, b time.Time) bool { return a.Before(b) })
+//	pq.Enqueue("retry", time.Now().Add(time.Second))
+func NewLess[T, P any](less func(a, b P) bool, opts ...Option) *Queue[T, P] {
+	return newQueue(func(a, b *priorityQueueItem[T, P]) bool {
+		return less(a.priority, b.priority)
+	}, opts)
+}
+
+// FromSeq2 creates and returns a new PriorityQueue containing the value-priority pairs yielded by seq.
+// The heap is built once after all pairs are collected, which is faster than repeated Enqueue calls.
+// Time complexity: O(n) where n is the number of pairs yielded.
+//
+// Parameters:
+//   - seq: the sequence of value-priority pairs to initialize the queue with
+//   - opts: optional settings such as WithMaxHeap and WithStable
+//
+// Returns:
+//   - a new PriorityQueue containing the pairs from the sequence
+//
+// Example:
+//
+//	tasks := map[string]int{"urgent": 1, "normal": 5}
+//	pq := FromSeq2(maps.All(tasks))
+func FromSeq2[T any](seq iter.Seq2[T, int], opts ...Option) *PriorityQueue[T] {
+	pq := New[T](opts...)
+	for value, priority := range seq {
+		item := &priorityQueueItem[T, int]{value: value, priority: priority, seq: pq.nextSeq, index: len(pq.items)}
+		pq.items = append(pq.items, item)
+		pq.nextSeq++
+	}
 	heap.Init(pq)
 	return pq
 }
 
 // Enqueue adds an element to the priority queue with the specified priority.
-// Elements with lower priority values will be dequeued first.
+// Elements with lower priority values will be dequeued first, unless the queue was built
+// with a custom ordering or WithMaxHeap.
 // Time complexity: O(log n) where n is the number of elements.
 //
 // Parameters:
 //   - value: the element to add to the queue
-//   - priority: the priority of the element (lower = higher precedence)
+//   - priority: the priority of the element (lower = higher precedence by default)
 //
 // Example:
 //
 //	pq.Enqueue("urgent", 1)      // High priority
 //	pq.Enqueue("normal", 5)      // Medium priority
 //	pq.Enqueue("low", 10)        // Low priority
-func (pq *PriorityQueue[T]) Enqueue(value T, priority int) {
-	item := priorityQueueItem[T]{value: value, priority: priority}
+func (pq *Queue[T, P]) Enqueue(value T, priority P) {
+	pq.push(value, priority)
+}
+
+// EnqueueHandle adds an element to the priority queue and returns a handle to it.
+// The handle stays valid while the element is in the queue and can be passed to
+// UpdatePriority, Remove and Contains, which makes decrease-key and cancellation possible.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - value: the element to add to the queue
+//   - priority: the priority of the element
+//
+// Returns:
+//   - a handle identifying the enqueued element
+//
+// Example:
+//
+//	h := pq.EnqueueHandle("node-b", 10)
+//	pq.UpdatePriority(h, 3)  // Found a shorter path
+func (pq *Queue[T, P]) EnqueueHandle(value T, priority P) Handle[T, P] {
+	return Handle[T, P]{item: pq.push(value, priority)}
+}
+
+// push creates an item for value, pushes it onto the heap and returns it.
+func (pq *Queue[T, P]) push(value T, priority P) *priorityQueueItem[T, P] {
+	item := &priorityQueueItem[T, P]{value: value, priority: priority, seq: pq.nextSeq}
+	pq.nextSeq++
 	heap.Push(pq, item)
+	return item
+}
+
+// Contains reports whether the element identified by h is still in this queue.
+// It returns false once the element has been dequeued or removed, after Clear,
+// for the zero Handle, and for handles issued by a different queue.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - h: the handle returned by EnqueueHandle
+//
+// Returns:
+//   - true if the element is in the queue, false otherwise
+//
+// Example:
+//
+//	if pq.Contains(h) {
+//	    fmt.Println("Timer still pending")
+//	}
+func (pq *Queue[T, P]) Contains(h Handle[T, P]) bool {
+	item := h.item
+	return item != nil && item.index >= 0 && item.index < len(pq.items) && pq.items[item.index] == item
+}
+
+// UpdatePriority changes the priority of the element identified by h and restores heap order.
+// It returns false without changing anything if the element is no longer in the queue.
+// In stable mode the element keeps its original insertion sequence number.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - h: the handle returned by EnqueueHandle
+//   - priority: the new priority of the element
+//
+// Returns:
+//   - true if the priority was updated, false if the element is not in the queue
+//
+// Example:
+//
+//	pq.UpdatePriority(h, newDistance)  // Decrease-key for Dijkstra
+func (pq *Queue[T, P]) UpdatePriority(h Handle[T, P], priority P) bool {
+	if !pq.Contains(h) {
+		return false
+	}
+	h.item.priority = priority
+	heap.Fix(pq, h.item.index)
+	return true
 }
 
-// Dequeue removes and returns the element with the highest priority (lowest priority value).
+// Remove removes the element identified by h from the queue regardless of its position.
+// It returns the zero value and false if the element is no longer in the queue.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - h: the handle returned by EnqueueHandle
+//
+// Returns:
+//   - value: the removed element, or zero value if it was not in the queue
+//   - ok: true if the element was removed, false otherwise
+//
+// Example:
+//
+//	if _, ok := pq.Remove(h); ok {
+//	    fmt.Println("Timer cancelled")
+//	}
+func (pq *Queue[T, P]) Remove(h Handle[T, P]) (T, bool) {
+	if !pq.Contains(h) {
+		var zero T
+		return zero, false
+	}
+	heap.Remove(pq, h.item.index)
+	return h.item.value, true
+}
+
+// Dequeue removes and returns the element with the highest priority (lowest priority value by default).
 // If the queue is empty, returns the zero value of type T.
 // Time complexity: O(log n) where n is the number of elements.
 //
@@ -99,11 +438,11 @@ func (pq *PriorityQueue[T]) Enqueue(value T, priority int) {
 //	if !pq.IsEmpty() {
 //	    next := pq.Dequeue()  // Get next highest priority element
 //	}
-func (pq *PriorityQueue[T]) Dequeue() T {
+func (pq *Queue[T, P]) Dequeue() T {
 	var value T
 	if pq.Len() != 0 {
 		item := heap.Pop(pq)
-		return item.(priorityQueueItem[T]).value
+		return item.(*priorityQueueItem[T, P]).value
 	}
 	return value
 }
@@ -121,10 +460,89 @@ func (pq *PriorityQueue[T]) Dequeue() T {
 //	if !pq.IsEmpty() {
 //	    fmt.Printf("Next element: %v\n", next)
 //	}
-func (pq *PriorityQueue[T]) Peek() T {
+func (pq *Queue[T, P]) Peek() T {
 	var value T
 	if pq.Len() != 0 {
-		value = (*pq)[0].value
+		value = pq.items[0].value
+	}
+	return value
+}
+
+// TryDequeue removes and returns the element with the highest priority.
+// Unlike Dequeue, it reports whether an element was actually removed, which distinguishes
+// an empty queue from a stored zero value.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Returns:
+//   - value: the element with the highest priority, or zero value if queue is empty
+//   - ok: true if an element was removed, false if the queue was empty
+//
+// Example:
+//
+//	if value, ok := pq.TryDequeue(); ok {
+//	    fmt.Printf("Processing: %v\n", value)
+//	}
+func (pq *Queue[T, P]) TryDequeue() (T, bool) {
+	if pq.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.Dequeue(), true
+}
+
+// TryPeek returns the element with the highest priority without removing it.
+// Unlike Peek, it reports whether the queue had an element.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the element with the highest priority, or zero value if queue is empty
+//   - ok: true if the queue is not empty, false otherwise
+//
+// Example:
+//
+//	if next, ok := pq.TryPeek(); ok {
+//	    fmt.Printf("Next element: %v\n", next)
+//	}
+func (pq *Queue[T, P]) TryPeek() (T, bool) {
+	if pq.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.items[0].value, true
+}
+
+// MustDequeue removes and returns the element with the highest priority.
+// It panics with an error wrapping errs.ErrEmpty if the queue is empty.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Returns:
+//   - the element with the highest priority
+//
+// Example:
+//
+//	value := pq.MustDequeue()  // Panics if queue is empty
+func (pq *Queue[T, P]) MustDequeue() T {
+	value, ok := pq.TryDequeue()
+	if !ok {
+		panic(fmt.Errorf("priorityqueue: Dequeue: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// MustPeek returns the element with the highest priority without removing it.
+// It panics with an error wrapping errs.ErrEmpty if the queue is empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the element with the highest priority
+//
+// Example:
+//
+//	next := pq.MustPeek()  // Panics if queue is empty
+func (pq *Queue[T, P]) MustPeek() T {
+	value, ok := pq.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("priorityqueue: Peek: %w", errs.ErrEmpty))
 	}
 	return value
 }
@@ -139,7 +557,7 @@ func (pq *PriorityQueue[T]) Peek() T {
 //
 //	count := pq.Size()
 //	fmt.Printf("Priority queue has %d elements\n", count)
-func (pq *PriorityQueue[T]) Size() int {
+func (pq *Queue[T, P]) Size() int {
 	return pq.Len()
 }
 
@@ -154,19 +572,23 @@ func (pq *PriorityQueue[T]) Size() int {
 //	if pq.IsEmpty() {
 //	    fmt.Println("Priority queue is empty")
 //	}
-func (pq *PriorityQueue[T]) IsEmpty() bool {
+func (pq *Queue[T, P]) IsEmpty() bool {
 	return pq.Len() == 0
 }
 
 // Clear removes all elements from the priority queue, making it empty.
-// Time complexity: O(1).
+// Time complexity: O(n) where n is the number of elements, since outstanding handles are invalidated.
 //
 // Example:
 //
 //	pq.Clear()  // Remove all elements
 //	fmt.Printf("Priority queue is now empty: %t\n", pq.IsEmpty())
-func (pq *PriorityQueue[T]) Clear() {
-	*pq = (*pq)[:0]
+func (pq *Queue[T, P]) Clear() {
+	for i, item := range pq.items {
+		item.index = -1
+		pq.items[i] = nil
+	}
+	pq.items = pq.items[:0]
 }
 
 // ToSlice returns a slice containing all elements in the priority queue.
@@ -182,10 +604,34 @@ func (pq *PriorityQueue[T]) Clear() {
 //
 //	elements := pq.ToSlice()  // Get all elements as slice
 //	fmt.Printf("Queue contents: %v\n", elements)
-func (pq *PriorityQueue[T]) ToSlice() []T {
+func (pq *Queue[T, P]) ToSlice() []T {
 	slice := make([]T, pq.Len())
-	for i, item := range *pq {
+	for i, item := range pq.items {
 		slice[i] = item.value
 	}
 	return slice
 }
+
+// All returns an iterator over the elements of the priority queue.
+// The elements are yielded in heap order, not priority order, matching ToSlice.
+// For priority-ordered access, use repeated Dequeue operations.
+// The queue must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, with no allocation of an intermediate slice.
+//
+// Returns:
+//   - an iter.Seq yielding each element of the priority queue
+//
+// Example:
+//
+//	for v := range pq.All() {
+//	    fmt.Println(v)
+//	}
+func (pq *Queue[T, P]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range pq.items {
+			if !yield(item.value) {
+				return
+			}
+		}
+	}
+}
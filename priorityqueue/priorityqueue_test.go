@@ -1,8 +1,15 @@
 package priorityqueue
 
 import (
+	"errors"
+	"maps"
+	"math/rand/v2"
 	"reflect"
+	"slices"
 	"testing"
+	"time"
+
+	"github.com/thefrost13/gollections/errs"
 )
 
 func TestNew(t *testing.T) {
@@ -32,6 +39,45 @@ func TestNew(t *testing.T) {
 	})
 }
 
+func TestPriorityQueueZeroValue(t *testing.T) {
+	t.Run("var declaration", func(t *testing.T) {
+		var pq PriorityQueue[string]
+		pq.Enqueue("low", 10)
+		pq.Enqueue("high", 1)
+		pq.Enqueue("medium", 5)
+		h := pq.EnqueueHandle("urgent", 7)
+		pq.UpdatePriority(h, 0)
+
+		expected := []string{"urgent", "high", "medium", "low"}
+		for _, want := range expected {
+			if got := pq.Dequeue(); got != want {
+				t.Errorf("Expected %s, got %s", want, got)
+			}
+		}
+	})
+
+	t.Run("composite literal", func(t *testing.T) {
+		pq := PriorityQueue[int]{}
+		for _, p := range []int{3, 1, 2} {
+			pq.Enqueue(p*10, p)
+		}
+		if pq.Peek() != 10 || pq.Size() != 3 {
+			t.Errorf("Expected peek 10 and size 3, got %d and %d", pq.Peek(), pq.Size())
+		}
+	})
+
+	t.Run("other priority types need a constructor", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected zero Queue with string priorities to panic")
+			}
+		}()
+		var pq Queue[int, string]
+		pq.Enqueue(1, "b")
+		pq.Enqueue(2, "a")
+	})
+}
+
 func TestPriorityQueueEnqueue(t *testing.T) {
 	t.Run("enqueue single item", func(t *testing.T) {
 		pq := New[string]()
@@ -589,6 +635,475 @@ func TestPriorityQueueIntegration(t *testing.T) {
 	})
 }
 
+func TestPriorityQueueFromSeq2(t *testing.T) {
+	t.Run("collect pairs in priority order", func(t *testing.T) {
+		pairs := map[string]int{"low": 10, "high": 1, "medium": 5}
+		pq := FromSeq2(maps.All(pairs))
+		if pq.Size() != 3 {
+			t.Errorf("Expected size 3, got %d", pq.Size())
+		}
+		expected := []string{"high", "medium", "low"}
+		for i, exp := range expected {
+			if actual := pq.Dequeue(); actual != exp {
+				t.Errorf("Dequeue %d: expected '%s', got '%s'", i, exp, actual)
+			}
+		}
+	})
+
+	t.Run("collect from empty sequence", func(t *testing.T) {
+		pq := FromSeq2(maps.All(map[string]int{}))
+		if !pq.IsEmpty() {
+			t.Error("Expected empty queue")
+		}
+		pq.Enqueue("a", 1)
+		if pq.Peek() != "a" {
+			t.Error("Expected queue to be usable after empty collect")
+		}
+	})
+}
+
+func TestPriorityQueueAll(t *testing.T) {
+	t.Run("all matches to slice", func(t *testing.T) {
+		pq := New[int]()
+		for i := 0; i < 10; i++ {
+			pq.Enqueue(i, 10-i)
+		}
+		got := slices.Collect(pq.All())
+		if !reflect.DeepEqual(got, pq.ToSlice()) {
+			t.Errorf("Expected %v, got %v", pq.ToSlice(), got)
+		}
+	})
+
+	t.Run("early break", func(t *testing.T) {
+		pq := New[int]()
+		pq.Enqueue(1, 1)
+		pq.Enqueue(2, 2)
+		count := 0
+		for range pq.All() {
+			count++
+			break
+		}
+		if count != 1 {
+			t.Errorf("Expected 1 iteration, got %d", count)
+		}
+	})
+}
+
+// recoverError runs fn and returns the error it panicked with, or nil if it did not panic.
+func recoverError(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, _ = r.(error)
+		}
+	}()
+	fn()
+	return nil
+}
+
+func TestPriorityQueueTryOperations(t *testing.T) {
+	t.Run("try on empty queue", func(t *testing.T) {
+		pq := New[int]()
+		if _, ok := pq.TryDequeue(); ok {
+			t.Error("Expected TryDequeue to report empty queue")
+		}
+		if _, ok := pq.TryPeek(); ok {
+			t.Error("Expected TryPeek to report empty queue")
+		}
+	})
+
+	t.Run("try distinguishes stored zero value", func(t *testing.T) {
+		pq := New[int]()
+		pq.Enqueue(0, 1)
+		if value, ok := pq.TryPeek(); !ok || value != 0 {
+			t.Errorf("Expected (0, true), got (%d, %t)", value, ok)
+		}
+		if value, ok := pq.TryDequeue(); !ok || value != 0 {
+			t.Errorf("Expected (0, true), got (%d, %t)", value, ok)
+		}
+	})
+
+	t.Run("try dequeue follows priority order", func(t *testing.T) {
+		pq := New[string]()
+		pq.Enqueue("low", 5)
+		pq.Enqueue("high", 1)
+		first, _ := pq.TryDequeue()
+		second, _ := pq.TryDequeue()
+		if first != "high" || second != "low" {
+			t.Errorf("Expected high, low; got %s, %s", first, second)
+		}
+	})
+}
+
+func TestPriorityQueueMustOperations(t *testing.T) {
+	t.Run("must on empty queue panics with ErrEmpty", func(t *testing.T) {
+		pq := New[int]()
+		if err := recoverError(func() { pq.MustDequeue() }); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected MustDequeue to panic with ErrEmpty, got %v", err)
+		}
+		if err := recoverError(func() { pq.MustPeek() }); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected MustPeek to panic with ErrEmpty, got %v", err)
+		}
+	})
+
+	t.Run("must on non-empty queue", func(t *testing.T) {
+		pq := New[int]()
+		pq.Enqueue(10, 2)
+		pq.Enqueue(20, 1)
+		if pq.MustPeek() != 20 {
+			t.Error("Expected MustPeek to return 20")
+		}
+		if pq.MustDequeue() != 20 || pq.MustDequeue() != 10 {
+			t.Error("Expected MustDequeue to return 20 then 10")
+		}
+	})
+}
+
+func TestNewOrdered(t *testing.T) {
+	t.Run("float64 priorities", func(t *testing.T) {
+		pq := NewOrdered[string, float64]()
+		pq.Enqueue("c", 2.5)
+		pq.Enqueue("a", -1.25)
+		pq.Enqueue("b", 0.75)
+
+		expected := []string{"a", "b", "c"}
+		for i, exp := range expected {
+			if actual := pq.Dequeue(); actual != exp {
+				t.Errorf("Dequeue %d: expected '%s', got '%s'", i, exp, actual)
+			}
+		}
+	})
+
+	t.Run("string priorities", func(t *testing.T) {
+		pq := NewOrdered[int, string]()
+		pq.Enqueue(3, "charlie")
+		pq.Enqueue(1, "alpha")
+		pq.Enqueue(2, "bravo")
+
+		if pq.Peek() != 1 {
+			t.Errorf("Expected peek 1, got %d", pq.Peek())
+		}
+	})
+
+	t.Run("max heap", func(t *testing.T) {
+		pq := NewOrdered[string, float64](WithMaxHeap())
+		pq.Enqueue("small", 0.1)
+		pq.Enqueue("large", 99.9)
+		pq.Enqueue("medium", 5)
+
+		expected := []string{"large", "medium", "small"}
+		for i, exp := range expected {
+			if actual := pq.Dequeue(); actual != exp {
+				t.Errorf("Dequeue %d: expected '%s', got '%s'", i, exp, actual)
+			}
+		}
+	})
+}
+
+func TestNewLess(t *testing.T) {
+	t.Run("time priorities", func(t *testing.T) {
+		base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		pq := NewLess[string](func(a, b time.Time) bool { return a.Before(b) })
+		pq.Enqueue("third", base.Add(3*time.Hour))
+		pq.Enqueue("first", base.Add(time.Minute))
+		pq.Enqueue("second", base.Add(time.Hour))
+
+		expected := []string{"first", "second", "third"}
+		for i, exp := range expected {
+			if actual := pq.Dequeue(); actual != exp {
+				t.Errorf("Dequeue %d: expected '%s', got '%s'", i, exp, actual)
+			}
+		}
+	})
+
+	t.Run("composite priorities", func(t *testing.T) {
+		type rank struct {
+			tier  int
+			score float64
+		}
+		pq := NewLess[string](func(a, b rank) bool {
+			if a.tier != b.tier {
+				return a.tier < b.tier
+			}
+			return a.score > b.score
+		})
+		pq.Enqueue("tier2", rank{tier: 2, score: 100})
+		pq.Enqueue("tier1-low", rank{tier: 1, score: 10})
+		pq.Enqueue("tier1-high", rank{tier: 1, score: 50})
+
+		expected := []string{"tier1-high", "tier1-low", "tier2"}
+		for i, exp := range expected {
+			if actual := pq.Dequeue(); actual != exp {
+				t.Errorf("Dequeue %d: expected '%s', got '%s'", i, exp, actual)
+			}
+		}
+	})
+}
+
+func TestPriorityQueueMaxHeap(t *testing.T) {
+	t.Run("new with max heap", func(t *testing.T) {
+		var pq *PriorityQueue[string] = New[string](WithMaxHeap())
+		pq.Enqueue("low", 1)
+		pq.Enqueue("high", 10)
+		pq.Enqueue("medium", 5)
+
+		expected := []string{"high", "medium", "low"}
+		for i, exp := range expected {
+			if actual := pq.Dequeue(); actual != exp {
+				t.Errorf("Dequeue %d: expected '%s', got '%s'", i, exp, actual)
+			}
+		}
+	})
+
+	t.Run("from seq with max heap", func(t *testing.T) {
+		pq := FromSeq2(maps.All(map[string]int{"a": 1, "b": 3, "c": 2}), WithMaxHeap())
+		if pq.Peek() != "b" {
+			t.Errorf("Expected peek 'b', got '%s'", pq.Peek())
+		}
+	})
+}
+
+func TestPriorityQueueStable(t *testing.T) {
+	t.Run("equal priorities dequeue in insertion order", func(t *testing.T) {
+		pq := New[string](WithStable())
+		pq.Enqueue("a", 1)
+		pq.Enqueue("b", 1)
+		pq.Enqueue("c", 0)
+		pq.Enqueue("d", 1)
+		pq.Enqueue("e", 0)
+
+		expected := []string{"c", "e", "a", "b", "d"}
+		for i, exp := range expected {
+			if actual := pq.Dequeue(); actual != exp {
+				t.Errorf("Dequeue %d: expected '%s', got '%s'", i, exp, actual)
+			}
+		}
+	})
+
+	t.Run("interleaved operations preserve FIFO among ties", func(t *testing.T) {
+		type job struct {
+			priority int
+			id       int
+		}
+		rng := rand.New(rand.NewPCG(1, 2))
+		pq := New[job](WithStable())
+		pending := make(map[int][]int) // priority -> ids in submission order
+		nextID := 0
+
+		for op := 0; op < 10000; op++ {
+			if pq.IsEmpty() || rng.IntN(3) != 0 {
+				p := rng.IntN(4)
+				pq.Enqueue(job{priority: p, id: nextID}, p)
+				pending[p] = append(pending[p], nextID)
+				nextID++
+				continue
+			}
+
+			got := pq.Dequeue()
+			lowest := -1
+			for p := 0; p < 4; p++ {
+				if len(pending[p]) > 0 {
+					lowest = p
+					break
+				}
+			}
+			if got.priority != lowest {
+				t.Fatalf("Op %d: expected priority %d, got %d", op, lowest, got.priority)
+			}
+			if want := pending[lowest][0]; got.id != want {
+				t.Fatalf("Op %d: expected id %d among priority %d, got %d", op, want, lowest, got.id)
+			}
+			pending[lowest] = pending[lowest][1:]
+		}
+	})
+
+	t.Run("stable max heap", func(t *testing.T) {
+		pq := NewOrdered[string, float64](WithMaxHeap(), WithStable())
+		pq.Enqueue("a", 1.5)
+		pq.Enqueue("b", 2.5)
+		pq.Enqueue("c", 1.5)
+		pq.Enqueue("d", 2.5)
+
+		expected := []string{"b", "d", "a", "c"}
+		for i, exp := range expected {
+			if actual := pq.Dequeue(); actual != exp {
+				t.Errorf("Dequeue %d: expected '%s', got '%s'", i, exp, actual)
+			}
+		}
+	})
+
+	t.Run("stable from seq", func(t *testing.T) {
+		pairs := func(yield func(string, int) bool) {
+			for _, v := range []string{"x", "y", "z"} {
+				if !yield(v, 7) {
+					return
+				}
+			}
+		}
+		pq := FromSeq2(pairs, WithStable())
+		expected := []string{"x", "y", "z"}
+		for i, exp := range expected {
+			if actual := pq.Dequeue(); actual != exp {
+				t.Errorf("Dequeue %d: expected '%s', got '%s'", i, exp, actual)
+			}
+		}
+	})
+}
+
+func TestPriorityQueueHandles(t *testing.T) {
+	t.Run("update priority reorders", func(t *testing.T) {
+		pq := New[string]()
+		a := pq.EnqueueHandle("a", 5)
+		pq.Enqueue("b", 3)
+		c := pq.EnqueueHandle("c", 1)
+
+		if !pq.UpdatePriority(a, 0) {
+			t.Fatal("Expected UpdatePriority to succeed")
+		}
+		if a.Priority() != 0 {
+			t.Errorf("Expected handle priority 0, got %d", a.Priority())
+		}
+		if !pq.UpdatePriority(c, 10) {
+			t.Fatal("Expected UpdatePriority to succeed")
+		}
+
+		expected := []string{"a", "b", "c"}
+		for i, exp := range expected {
+			if actual := pq.Dequeue(); actual != exp {
+				t.Errorf("Dequeue %d: expected '%s', got '%s'", i, exp, actual)
+			}
+		}
+	})
+
+	t.Run("remove by handle", func(t *testing.T) {
+		pq := New[string]()
+		pq.Enqueue("a", 1)
+		b := pq.EnqueueHandle("b", 2)
+		pq.Enqueue("c", 3)
+
+		value, ok := pq.Remove(b)
+		if !ok || value != "b" {
+			t.Errorf("Expected (b, true), got (%s, %t)", value, ok)
+		}
+		if pq.Size() != 2 {
+			t.Errorf("Expected size 2, got %d", pq.Size())
+		}
+		if _, ok := pq.Remove(b); ok {
+			t.Error("Expected second Remove to fail")
+		}
+		if pq.UpdatePriority(b, 0) {
+			t.Error("Expected UpdatePriority on removed handle to fail")
+		}
+		if pq.Dequeue() != "a" || pq.Dequeue() != "c" {
+			t.Error("Expected remaining elements a, c")
+		}
+	})
+
+	t.Run("contains tracks membership", func(t *testing.T) {
+		pq := New[int]()
+		h := pq.EnqueueHandle(42, 1)
+		if !pq.Contains(h) {
+			t.Error("Expected queue to contain handle")
+		}
+		if h.Value() != 42 {
+			t.Errorf("Expected handle value 42, got %d", h.Value())
+		}
+
+		pq.Dequeue()
+		if pq.Contains(h) {
+			t.Error("Expected dequeued handle to not be contained")
+		}
+
+		h = pq.EnqueueHandle(7, 1)
+		pq.Clear()
+		if pq.Contains(h) {
+			t.Error("Expected handle to not be contained after Clear")
+		}
+
+		var zero Handle[int, int]
+		if pq.Contains(zero) {
+			t.Error("Expected zero handle to not be contained")
+		}
+		if zero.Value() != 0 || zero.Priority() != 0 {
+			t.Error("Expected zero handle to report zero values")
+		}
+	})
+
+	t.Run("handle from another queue", func(t *testing.T) {
+		pq1 := New[int]()
+		pq2 := New[int]()
+		h := pq1.EnqueueHandle(1, 1)
+		pq2.Enqueue(2, 1)
+		if pq2.Contains(h) {
+			t.Error("Expected handle to be rejected by another queue")
+		}
+		if _, ok := pq2.Remove(h); ok {
+			t.Error("Expected Remove with foreign handle to fail")
+		}
+	})
+
+	t.Run("random updates and removes keep heap order", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(3, 4))
+		pq := New[int]()
+		handles := make([]Handle[int, int], 500)
+		for i := range handles {
+			handles[i] = pq.EnqueueHandle(i, rng.IntN(1000))
+		}
+		for i := 0; i < 200; i++ {
+			h := handles[rng.IntN(len(handles))]
+			if rng.IntN(2) == 0 {
+				pq.UpdatePriority(h, rng.IntN(1000))
+			} else {
+				pq.Remove(h)
+			}
+		}
+
+		last := -1
+		for !pq.IsEmpty() {
+			h := handles[pq.Peek()]
+			if h.Priority() < last {
+				t.Fatalf("Priority %d dequeued after %d", h.Priority(), last)
+			}
+			last = h.Priority()
+			pq.Dequeue()
+		}
+	})
+
+	t.Run("dijkstra shortest paths", func(t *testing.T) {
+		// edges[u] lists (v, weight) pairs
+		edges := map[int][][2]int{
+			0: {{1, 4}, {2, 1}},
+			2: {{1, 2}, {3, 5}},
+			1: {{3, 1}},
+		}
+		dist := map[int]int{0: 0}
+		handles := map[int]Handle[int, int]{}
+		pq := New[int]()
+		handles[0] = pq.EnqueueHandle(0, 0)
+
+		for !pq.IsEmpty() {
+			u := pq.Dequeue()
+			for _, e := range edges[u] {
+				v, w := e[0], e[1]
+				nd := dist[u] + w
+				if d, seen := dist[v]; seen && d <= nd {
+					continue
+				}
+				dist[v] = nd
+				if h, ok := handles[v]; ok && pq.Contains(h) {
+					pq.UpdatePriority(h, nd)
+				} else {
+					handles[v] = pq.EnqueueHandle(v, nd)
+				}
+			}
+		}
+
+		expected := map[int]int{0: 0, 1: 3, 2: 1, 3: 4}
+		if !reflect.DeepEqual(dist, expected) {
+			t.Errorf("Expected distances %v, got %v", expected, dist)
+		}
+	})
+}
+
 // Benchmark tests
 func BenchmarkPriorityQueueEnqueue(b *testing.B) {
 	pq := New[int]()
@@ -0,0 +1,294 @@
+package priorityqueue
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncQueue is a priority Queue that is safe for concurrent use by multiple goroutines.
+// Reads take a shared lock and writes take an exclusive lock on a sync.RWMutex,
+// and compound operations such as TryDequeue and DequeueIf are performed atomically.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+//   - P: the priority type of the wrapped queue
+type SyncQueue[T, P any] struct {
+	mu    sync.RWMutex // guards queue
+	queue *Queue[T, P] // the wrapped queue
+}
+
+// SyncPriorityQueue is a SyncQueue with int priorities.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type SyncPriorityQueue[T any] = SyncQueue[T, int]
+
+// NewSync creates and returns a new SyncQueue wrapping the given queue.
+// The caller must not use pq directly afterwards. Since the ordering of a queue is
+// fixed by its constructor, pq must not be nil.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - pq: the queue to guard, built with New, NewOrdered or NewLess
+//
+// Returns:
+//   - a new SyncQueue guarding pq
+//
+// Example:
+//
+//	pq := NewSync(New[string](WithStable()))
+//	go pq.Enqueue("job", 1)
+func NewSync[T, P any](pq *Queue[T, P]) *SyncQueue[T, P] {
+	return &SyncQueue[T, P]{queue: pq}
+}
+
+// Enqueue adds an element to the priority queue with the specified priority.
+// Time complexity: O(log n) where n is the number of elements.
+func (pq *SyncQueue[T, P]) Enqueue(value T, priority P) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	pq.queue.Enqueue(value, priority)
+}
+
+// EnqueueHandle adds an element to the priority queue and returns a handle to it.
+// Time complexity: O(log n) where n is the number of elements.
+func (pq *SyncQueue[T, P]) EnqueueHandle(value T, priority P) Handle[T, P] {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	return pq.queue.EnqueueHandle(value, priority)
+}
+
+// Dequeue removes and returns the element with the highest priority.
+// If the queue is empty, returns the zero value of type T.
+// Time complexity: O(log n) where n is the number of elements.
+func (pq *SyncQueue[T, P]) Dequeue() T {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	return pq.queue.Dequeue()
+}
+
+// Peek returns the element with the highest priority without removing it.
+// If the queue is empty, returns the zero value of type T.
+// Time complexity: O(1).
+func (pq *SyncQueue[T, P]) Peek() T {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	return pq.queue.Peek()
+}
+
+// TryDequeue atomically removes and returns the element with the highest priority if the queue is not empty.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Returns:
+//   - value: the element with the highest priority, or zero value if queue is empty
+//   - ok: true if an element was removed, false if the queue was empty
+func (pq *SyncQueue[T, P]) TryDequeue() (T, bool) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	return pq.queue.TryDequeue()
+}
+
+// TryPeek returns the element with the highest priority without removing it.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the element with the highest priority, or zero value if queue is empty
+//   - ok: true if the queue is not empty, false otherwise
+func (pq *SyncQueue[T, P]) TryPeek() (T, bool) {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	return pq.queue.TryPeek()
+}
+
+// MustDequeue removes and returns the element with the highest priority.
+// It panics with an error wrapping errs.ErrEmpty if the queue is empty.
+// Time complexity: O(log n) where n is the number of elements.
+func (pq *SyncQueue[T, P]) MustDequeue() T {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	return pq.queue.MustDequeue()
+}
+
+// MustPeek returns the element with the highest priority without removing it.
+// It panics with an error wrapping errs.ErrEmpty if the queue is empty.
+// Time complexity: O(1).
+func (pq *SyncQueue[T, P]) MustPeek() T {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	return pq.queue.MustPeek()
+}
+
+// DequeueIf atomically removes and returns the element with the highest priority if the queue
+// is not empty and the element and its priority satisfy predicate. The predicate runs while
+// the lock is held and must not call methods on the same queue.
+// Time complexity: O(log n) plus the cost of predicate.
+//
+// Parameters:
+//   - predicate: reports whether the head element should be dequeued
+//
+// Returns:
+//   - value: the dequeued element, or zero value if nothing was dequeued
+//   - ok: true if an element was dequeued, false otherwise
+//
+// Example:
+//
+//	// Dequeue only jobs whose deadline has passed
+//	job, ok := pq.DequeueIf(func(_ Job, at int64) bool { return at <= now })
+func (pq *SyncQueue[T, P]) DequeueIf(predicate func(value T, priority P) bool) (T, bool) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	if pq.queue.Len() == 0 || !predicate(pq.queue.items[0].value, pq.queue.items[0].priority) {
+		var zero T
+		return zero, false
+	}
+	return pq.queue.TryDequeue()
+}
+
+// UpdatePriority changes the priority of the element identified by h and restores heap order.
+// Time complexity: O(log n) where n is the number of elements.
+func (pq *SyncQueue[T, P]) UpdatePriority(h Handle[T, P], priority P) bool {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	return pq.queue.UpdatePriority(h, priority)
+}
+
+// Priority returns the current priority of the element identified by h, reading it under the
+// queue's lock so that it is safe to call concurrently with UpdatePriority.
+// Time complexity: O(1).
+//
+// Returns:
+//   - priority: the element's priority, or zero value if it is no longer in the queue
+//   - ok: true if the element is in the queue, false otherwise
+func (pq *SyncQueue[T, P]) Priority(h Handle[T, P]) (P, bool) {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	if !pq.queue.Contains(h) {
+		var zero P
+		return zero, false
+	}
+	return h.item.priority, true
+}
+
+// Remove removes the element identified by h from the queue.
+// Time complexity: O(log n) where n is the number of elements.
+func (pq *SyncQueue[T, P]) Remove(h Handle[T, P]) (T, bool) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	return pq.queue.Remove(h)
+}
+
+// Contains reports whether the element identified by h is still in the queue.
+// Time complexity: O(1).
+func (pq *SyncQueue[T, P]) Contains(h Handle[T, P]) bool {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	return pq.queue.Contains(h)
+}
+
+// Size returns the number of elements in the priority queue.
+// Time complexity: O(1).
+func (pq *SyncQueue[T, P]) Size() int {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	return pq.queue.Size()
+}
+
+// IsEmpty returns true if the priority queue contains no elements.
+// Time complexity: O(1).
+func (pq *SyncQueue[T, P]) IsEmpty() bool {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	return pq.queue.IsEmpty()
+}
+
+// Clear removes all elements from the priority queue, making it empty.
+// Time complexity: O(n) where n is the number of elements.
+func (pq *SyncQueue[T, P]) Clear() {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	pq.queue.Clear()
+}
+
+// ToSlice returns a slice containing all elements in the priority queue in heap order.
+// Time complexity: O(n) where n is the number of elements.
+func (pq *SyncQueue[T, P]) ToSlice() []T {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	return pq.queue.ToSlice()
+}
+
+// All returns an iterator over a snapshot of the elements in heap order.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the queue.
+// Time complexity: O(n) time and O(n) space.
+func (pq *SyncQueue[T, P]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range pq.ToSlice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler using the encoding of the wrapped queue.
+// A zero-value wrapper encodes as an empty queue.
+// Time complexity: O(n).
+func (pq *SyncQueue[T, P]) MarshalJSON() ([]byte, error) {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	if pq.queue == nil {
+		return (&Queue[T, P]{}).MarshalJSON()
+	}
+	return pq.queue.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of the wrapped queue.
+// A zero-value SyncPriorityQueue gets the int min-heap of New to decode into; a zero-value
+// wrapper with any other priority type has no ordering and fails with errNoOrdering.
+// Time complexity: O(n).
+func (pq *SyncQueue[T, P]) UnmarshalJSON(data []byte) error {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	if err := pq.ensureQueue(); err != nil {
+		return err
+	}
+	return pq.queue.UnmarshalJSON(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using the encoding of the wrapped queue.
+// A zero-value wrapper encodes as an empty queue.
+// Time complexity: O(n).
+func (pq *SyncQueue[T, P]) MarshalBinary() ([]byte, error) {
+	pq.mu.RLock()
+	defer pq.mu.RUnlock()
+	if pq.queue == nil {
+		return (&Queue[T, P]{}).MarshalBinary()
+	}
+	return pq.queue.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the wrapped queue.
+// A zero-value wrapper is handled as in UnmarshalJSON.
+// Time complexity: O(n).
+func (pq *SyncQueue[T, P]) UnmarshalBinary(data []byte) error {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	if err := pq.ensureQueue(); err != nil {
+		return err
+	}
+	return pq.queue.UnmarshalBinary(data)
+}
+
+// ensureQueue gives a zero-value wrapper an empty queue with the default ordering,
+// or returns errNoOrdering if its priority type has none. pq.mu must be held.
+func (pq *SyncQueue[T, P]) ensureQueue() error {
+	if pq.queue != nil {
+		return nil
+	}
+	queue := &Queue[T, P]{}
+	if !queue.hasOrder() {
+		return errNoOrdering
+	}
+	pq.queue = queue
+	return nil
+}
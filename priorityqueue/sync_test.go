@@ -0,0 +1,198 @@
+package priorityqueue
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/thefrost13/gollections/errs"
+)
+
+func TestSyncQueueOperations(t *testing.T) {
+	var pq *SyncPriorityQueue[string] = NewSync(New[string]())
+
+	pq.Enqueue("low", 10)
+	h := pq.EnqueueHandle("medium", 5)
+	pq.Enqueue("high", 1)
+
+	if value, ok := pq.TryPeek(); !ok || value != "high" {
+		t.Errorf("Expected (high, true), got (%s, %t)", value, ok)
+	}
+	if _, ok := pq.DequeueIf(func(_ string, p int) bool { return p > 1 }); ok {
+		t.Error("Expected DequeueIf to reject head element")
+	}
+	if value, ok := pq.DequeueIf(func(v string, p int) bool { return v == "high" && p == 1 }); !ok || value != "high" {
+		t.Errorf("Expected DequeueIf to dequeue high, got (%s, %t)", value, ok)
+	}
+
+	if !pq.Contains(h) || !pq.UpdatePriority(h, 20) {
+		t.Error("Expected handle to be updatable")
+	}
+	if pq.MustPeek() != "low" {
+		t.Errorf("Expected low after update, got %s", pq.Peek())
+	}
+	if p, ok := pq.Priority(h); !ok || p != 20 {
+		t.Errorf("Expected priority (20, true), got (%d, %t)", p, ok)
+	}
+	if value, ok := pq.Remove(h); !ok || value != "medium" {
+		t.Errorf("Expected Remove to return medium, got (%s, %t)", value, ok)
+	}
+	if _, ok := pq.Priority(h); ok {
+		t.Error("Expected no priority for a removed element")
+	}
+	if got := slices.Collect(pq.All()); !reflect.DeepEqual(got, pq.ToSlice()) {
+		t.Errorf("Expected All to match ToSlice, got %v", got)
+	}
+	if pq.MustDequeue() != "low" {
+		t.Error("Expected MustDequeue to return low")
+	}
+	if _, ok := pq.TryDequeue(); ok || !pq.IsEmpty() {
+		t.Error("Expected queue to be empty")
+	}
+	if err := recoverError(func() { pq.MustDequeue() }); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("Expected MustDequeue to panic with ErrEmpty, got %v", err)
+	}
+
+	pq.Enqueue("x", 1)
+	pq.Clear()
+	if pq.Size() != 0 || pq.Dequeue() != "" {
+		t.Error("Expected empty queue after Clear")
+	}
+}
+
+func TestSyncQueueConcurrent(t *testing.T) {
+	const goroutines = 32
+	const perGoroutine = 500
+
+	pq := NewSync(NewOrdered[int, int]())
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				v := g*perGoroutine + i
+				h := pq.EnqueueHandle(v, v+1)
+				pq.Peek()
+				pq.UpdatePriority(h, v)
+				if p, ok := pq.Priority(h); !ok || p != v {
+					t.Errorf("Expected priority (%d, true), got (%d, %t)", v, p, ok)
+				}
+				if h.Value() != v {
+					t.Errorf("Expected handle value %d, got %d", v, h.Value())
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	results := make(chan []int, goroutines)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var got []int
+			for {
+				value, ok := pq.TryDequeue()
+				if !ok {
+					break
+				}
+				got = append(got, value)
+			}
+			results <- got
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	var all []int
+	for got := range results {
+		if !sort.IntsAreSorted(got) {
+			t.Error("Expected each consumer to observe priorities in order")
+		}
+		all = append(all, got...)
+	}
+	sort.Ints(all)
+	if len(all) != goroutines*perGoroutine {
+		t.Fatalf("Expected %d values, got %d", goroutines*perGoroutine, len(all))
+	}
+	for i, v := range all {
+		if v != i {
+			t.Fatalf("Expected every value exactly once, mismatch at %d: %d", i, v)
+		}
+	}
+}
+
+func TestHandleValueConcurrent(t *testing.T) {
+	t.Run("without Update", func(t *testing.T) {
+		const n = 1000
+		pq := NewSync(NewOrdered[int, int]())
+		handles := make([]Handle[int, int], n)
+		for i := range handles {
+			handles[i] = pq.EnqueueHandle(i, i)
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i, h := range handles {
+				switch i % 3 {
+				case 0:
+					pq.UpdatePriority(h, -i)
+				case 1:
+					pq.Remove(h)
+				default:
+					pq.TryDequeue()
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i, h := range handles {
+				if h.Value() != i {
+					t.Errorf("Expected handle value %d, got %d", i, h.Value())
+				}
+			}
+		}()
+		wg.Wait()
+	})
+
+	t.Run("Update under a shared lock", func(t *testing.T) {
+		const n = 1000
+		var mu sync.Mutex
+		fq := NewFunc(func(a, b int) bool { return a < b })
+		h := fq.EnqueueHandle(0)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 1; i <= n; i++ {
+				mu.Lock()
+				fq.Update(h, i)
+				mu.Unlock()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			last := 0
+			for i := 0; i < n; i++ {
+				mu.Lock()
+				value := h.Value()
+				mu.Unlock()
+				if value < last {
+					t.Errorf("Expected value to only grow, got %d after %d", value, last)
+				}
+				last = value
+			}
+		}()
+		wg.Wait()
+		if h.Value() != n {
+			t.Errorf("Expected final value %d, got %d", n, h.Value())
+		}
+	})
+}
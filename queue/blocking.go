@@ -0,0 +1,333 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/thefrost13/gollections/errs"
+)
+
+// BlockingQueue is a FIFO queue for producer/consumer pipelines that is safe for concurrent use.
+// Take blocks while the queue is empty and Put blocks while a bounded queue is full.
+// Every blocking operation has a context-aware variant, and Offer/Poll accept timeouts.
+// After Close, Put fails with errs.ErrClosed, Take drains the remaining elements and then
+// fails with errs.ErrClosed, and all blocked callers are woken.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex    // guards every field below
+	queue    *Queue[T]     // the buffered elements
+	capacity int           // maximum number of elements, or 0 for unbounded
+	closed   bool          // whether Close has been called
+	notEmpty chan struct{} // closed to wake takers, nil while no taker is waiting
+	notFull  chan struct{} // closed to wake putters, nil while no putter is waiting
+}
+
+// NewBlocking creates and returns a new empty BlockingQueue holding at most capacity elements.
+// A capacity of zero or less makes the queue unbounded, so Put never blocks.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - capacity: the maximum number of buffered elements, or <= 0 for unbounded
+//
+// Returns:
+//   - a new empty BlockingQueue
+//
+// Example:
+//
+//	jobs := NewBlocking[Job](100)
+//	go func() {
+//	    for {
+//	        job, err := jobs.Take()
+//	        if err != nil {
+//	            return  // queue closed and drained
+//	        }
+//	        job.Run()
+//	    }
+//	}()
+func NewBlocking[T any](capacity int) *BlockingQueue[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &BlockingQueue[T]{
+		queue:    &Queue[T]{},
+		capacity: capacity,
+	}
+}
+
+// Put adds an element to the back of the queue, blocking while the queue is full.
+// Time complexity: O(1) once space is available.
+//
+// Parameters:
+//   - value: the element to add
+//
+// Returns:
+//   - nil on success, or errs.ErrClosed if the queue is closed
+//
+// Example:
+//
+//	if err := jobs.Put(job); err != nil {
+//	    log.Println("queue closed")
+//	}
+func (bq *BlockingQueue[T]) Put(value T) error {
+	return bq.PutContext(context.Background(), value)
+}
+
+// PutContext adds an element to the back of the queue, blocking while the queue is full
+// until ctx is cancelled or its deadline passes.
+// Time complexity: O(1) once space is available.
+//
+// Parameters:
+//   - ctx: controls how long to wait for space
+//   - value: the element to add
+//
+// Returns:
+//   - nil on success, ctx.Err() if ctx ends first, or errs.ErrClosed if the queue is closed
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	err := jobs.PutContext(ctx, job)
+func (bq *BlockingQueue[T]) PutContext(ctx context.Context, value T) error {
+	for {
+		bq.mu.Lock()
+		if bq.closed {
+			bq.mu.Unlock()
+			return errs.ErrClosed
+		}
+		if bq.capacity == 0 || bq.queue.Size() < bq.capacity {
+			bq.queue.Enqueue(value)
+			bq.wakeTakers()
+			bq.mu.Unlock()
+			return nil
+		}
+		if bq.notFull == nil {
+			bq.notFull = make(chan struct{})
+		}
+		wait := bq.notFull
+		bq.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Offer adds an element to the back of the queue, waiting at most timeout for space.
+// A timeout of zero or less makes a single non-blocking attempt.
+// Time complexity: O(1) once space is available.
+//
+// Parameters:
+//   - value: the element to add
+//   - timeout: the maximum time to wait for space
+//
+// Returns:
+//   - true if the element was added, false if the queue stayed full or is closed
+//
+// Example:
+//
+//	if !events.Offer(event, 10*time.Millisecond) {
+//	    dropped++
+//	}
+func (bq *BlockingQueue[T]) Offer(value T, timeout time.Duration) bool {
+	ctx, cancel := timeoutContext(timeout)
+	defer cancel()
+	return bq.PutContext(ctx, value) == nil
+}
+
+// Take removes and returns the front element, blocking while the queue is empty.
+// Time complexity: O(1) once an element is available.
+//
+// Returns:
+//   - value: the front element, or zero value on error
+//   - err: nil on success, or errs.ErrClosed if the queue is closed and drained
+//
+// Example:
+//
+//	for {
+//	    job, err := jobs.Take()
+//	    if errors.Is(err, errs.ErrClosed) {
+//	        break
+//	    }
+//	    job.Run()
+//	}
+func (bq *BlockingQueue[T]) Take() (T, error) {
+	return bq.TakeContext(context.Background())
+}
+
+// TakeContext removes and returns the front element, blocking while the queue is empty
+// until ctx is cancelled or its deadline passes.
+// Time complexity: O(1) once an element is available.
+//
+// Parameters:
+//   - ctx: controls how long to wait for an element
+//
+// Returns:
+//   - value: the front element, or zero value on error
+//   - err: nil on success, ctx.Err() if ctx ends first, or errs.ErrClosed if the queue is closed and drained
+//
+// Example:
+//
+//	job, err := jobs.TakeContext(ctx)
+//	if errors.Is(err, context.Canceled) {
+//	    return
+//	}
+func (bq *BlockingQueue[T]) TakeContext(ctx context.Context) (T, error) {
+	for {
+		bq.mu.Lock()
+		if value, ok := bq.queue.TryDequeue(); ok {
+			bq.wakePutters()
+			bq.mu.Unlock()
+			return value, nil
+		}
+		if bq.closed {
+			bq.mu.Unlock()
+			var zero T
+			return zero, errs.ErrClosed
+		}
+		if bq.notEmpty == nil {
+			bq.notEmpty = make(chan struct{})
+		}
+		wait := bq.notEmpty
+		bq.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// Poll removes and returns the front element, waiting at most timeout for one to arrive.
+// A timeout of zero or less makes a single non-blocking attempt.
+// Time complexity: O(1) once an element is available.
+//
+// Parameters:
+//   - timeout: the maximum time to wait for an element
+//
+// Returns:
+//   - value: the front element, or zero value if none was available
+//   - ok: true if an element was removed, false on timeout or if the queue is closed and drained
+//
+// Example:
+//
+//	if job, ok := jobs.Poll(time.Second); ok {
+//	    job.Run()
+//	}
+func (bq *BlockingQueue[T]) Poll(timeout time.Duration) (T, bool) {
+	ctx, cancel := timeoutContext(timeout)
+	defer cancel()
+	value, err := bq.TakeContext(ctx)
+	return value, err == nil
+}
+
+// Peek returns the front element without removing it and without blocking.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the front element, or zero value if the queue is empty
+//   - ok: true if the queue is not empty, false otherwise
+func (bq *BlockingQueue[T]) Peek() (T, bool) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.queue.TryPeek()
+}
+
+// Close marks the queue as closed and wakes every blocked Put and Take.
+// Elements already in the queue can still be taken. Calling Close more than once is a no-op.
+// Time complexity: O(1).
+//
+// Example:
+//
+//	defer jobs.Close()
+func (bq *BlockingQueue[T]) Close() {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	if bq.closed {
+		return
+	}
+	bq.closed = true
+	bq.wakeTakers()
+	bq.wakePutters()
+}
+
+// IsClosed returns true if Close has been called.
+// Time complexity: O(1).
+func (bq *BlockingQueue[T]) IsClosed() bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.closed
+}
+
+// Capacity returns the maximum number of elements the queue can hold, or 0 if it is unbounded.
+// Time complexity: O(1).
+func (bq *BlockingQueue[T]) Capacity() int {
+	return bq.capacity
+}
+
+// Size returns the number of elements in the queue.
+// Time complexity: O(1).
+func (bq *BlockingQueue[T]) Size() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.queue.Size()
+}
+
+// IsEmpty returns true if the queue contains no elements.
+// Time complexity: O(1).
+func (bq *BlockingQueue[T]) IsEmpty() bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.queue.IsEmpty()
+}
+
+// ToSlice returns a slice containing all elements in the queue in FIFO order.
+// Time complexity: O(n) where n is the number of elements.
+func (bq *BlockingQueue[T]) ToSlice() []T {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.queue.ToSlice()
+}
+
+// Clear removes all elements from the queue and wakes any blocked Put.
+// Time complexity: O(1).
+func (bq *BlockingQueue[T]) Clear() {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	bq.queue.Clear()
+	bq.wakePutters()
+}
+
+// wakeTakers wakes every Take waiting for an element. bq.mu must be held.
+func (bq *BlockingQueue[T]) wakeTakers() {
+	if bq.notEmpty != nil {
+		close(bq.notEmpty)
+		bq.notEmpty = nil
+	}
+}
+
+// wakePutters wakes every Put waiting for space. bq.mu must be held.
+func (bq *BlockingQueue[T]) wakePutters() {
+	if bq.notFull != nil {
+		close(bq.notFull)
+		bq.notFull = nil
+	}
+}
+
+// timeoutContext returns a context that expires after timeout, or one that is already
+// cancelled when timeout is zero or less so that callers make a single attempt.
+func timeoutContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		return ctx, cancel
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
@@ -0,0 +1,331 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thefrost13/gollections/errs"
+)
+
+func TestNewBlocking(t *testing.T) {
+	bq := NewBlocking[int](3)
+	if bq.Capacity() != 3 {
+		t.Errorf("Expected capacity 3, got %d", bq.Capacity())
+	}
+	if !bq.IsEmpty() || bq.Size() != 0 || bq.IsClosed() {
+		t.Error("Expected new queue to be empty and open")
+	}
+	if NewBlocking[int](-5).Capacity() != 0 {
+		t.Error("Expected negative capacity to mean unbounded")
+	}
+}
+
+func TestBlockingQueuePutTake(t *testing.T) {
+	t.Run("fifo order", func(t *testing.T) {
+		bq := NewBlocking[int](0)
+		for i := 1; i <= 3; i++ {
+			if err := bq.Put(i); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+		if value, ok := bq.Peek(); !ok || value != 1 {
+			t.Errorf("Expected peek (1, true), got (%d, %t)", value, ok)
+		}
+		if !reflect.DeepEqual(bq.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", bq.ToSlice())
+		}
+		for i := 1; i <= 3; i++ {
+			value, err := bq.Take()
+			if err != nil || value != i {
+				t.Errorf("Expected (%d, nil), got (%d, %v)", i, value, err)
+			}
+		}
+	})
+
+	t.Run("take blocks until put", func(t *testing.T) {
+		bq := NewBlocking[string](1)
+		result := make(chan string)
+		go func() {
+			value, _ := bq.Take()
+			result <- value
+		}()
+
+		select {
+		case <-result:
+			t.Fatal("Take returned before any Put")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		bq.Put("hello")
+		select {
+		case value := <-result:
+			if value != "hello" {
+				t.Errorf("Expected 'hello', got '%s'", value)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Take did not wake after Put")
+		}
+	})
+
+	t.Run("put blocks while full", func(t *testing.T) {
+		bq := NewBlocking[int](1)
+		bq.Put(1)
+		done := make(chan error)
+		go func() {
+			done <- bq.Put(2)
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("Put returned while queue was full")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		if value, _ := bq.Take(); value != 1 {
+			t.Errorf("Expected 1, got %d", value)
+		}
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Put did not wake after Take")
+		}
+		if value, _ := bq.Take(); value != 2 {
+			t.Errorf("Expected 2, got %d", value)
+		}
+	})
+
+	t.Run("clear wakes putters", func(t *testing.T) {
+		bq := NewBlocking[int](1)
+		bq.Put(1)
+		done := make(chan error)
+		go func() {
+			done <- bq.Put(2)
+		}()
+		time.Sleep(10 * time.Millisecond)
+		bq.Clear()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Put did not wake after Clear")
+		}
+	})
+}
+
+func TestBlockingQueueContext(t *testing.T) {
+	t.Run("take honors cancellation", func(t *testing.T) {
+		bq := NewBlocking[int](1)
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+		if _, err := bq.TakeContext(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("put honors deadline", func(t *testing.T) {
+		bq := NewBlocking[int](1)
+		bq.Put(1)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if err := bq.PutContext(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+		if bq.Size() != 1 {
+			t.Errorf("Expected size 1, got %d", bq.Size())
+		}
+	})
+
+	t.Run("cancelled context still succeeds when ready", func(t *testing.T) {
+		bq := NewBlocking[int](1)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := bq.PutContext(ctx, 1); err != nil {
+			t.Errorf("Expected Put into free space to succeed, got %v", err)
+		}
+		if value, err := bq.TakeContext(ctx); err != nil || value != 1 {
+			t.Errorf("Expected (1, nil), got (%d, %v)", value, err)
+		}
+	})
+}
+
+func TestBlockingQueueOfferPoll(t *testing.T) {
+	bq := NewBlocking[int](1)
+	if !bq.Offer(1, 0) {
+		t.Error("Expected non-blocking Offer into free space to succeed")
+	}
+	if bq.Offer(2, 0) {
+		t.Error("Expected non-blocking Offer into full queue to fail")
+	}
+
+	start := time.Now()
+	if bq.Offer(2, 20*time.Millisecond) {
+		t.Error("Expected Offer to time out")
+	}
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Errorf("Expected Offer to wait for its timeout, waited %v", elapsed)
+	}
+
+	if value, ok := bq.Poll(0); !ok || value != 1 {
+		t.Errorf("Expected (1, true), got (%d, %t)", value, ok)
+	}
+	if _, ok := bq.Poll(0); ok {
+		t.Error("Expected non-blocking Poll on empty queue to fail")
+	}
+	if _, ok := bq.Poll(20 * time.Millisecond); ok {
+		t.Error("Expected Poll to time out")
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		bq.Put(7)
+	}()
+	if value, ok := bq.Poll(time.Second); !ok || value != 7 {
+		t.Errorf("Expected (7, true), got (%d, %t)", value, ok)
+	}
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	t.Run("close wakes all waiters", func(t *testing.T) {
+		bq := NewBlocking[int](1)
+		const takers = 5
+		errsCh := make(chan error, takers)
+		for i := 0; i < takers; i++ {
+			go func() {
+				_, err := bq.Take()
+				errsCh <- err
+			}()
+		}
+		time.Sleep(10 * time.Millisecond)
+		bq.Close()
+		bq.Close() // second close is a no-op
+
+		for i := 0; i < takers; i++ {
+			select {
+			case err := <-errsCh:
+				if !errors.Is(err, errs.ErrClosed) {
+					t.Errorf("Expected ErrClosed, got %v", err)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("Close did not wake all takers")
+			}
+		}
+	})
+
+	t.Run("close wakes blocked putters", func(t *testing.T) {
+		bq := NewBlocking[int](1)
+		bq.Put(1)
+		done := make(chan error)
+		go func() {
+			done <- bq.Put(2)
+		}()
+		time.Sleep(10 * time.Millisecond)
+		bq.Close()
+		select {
+		case err := <-done:
+			if !errors.Is(err, errs.ErrClosed) {
+				t.Errorf("Expected ErrClosed, got %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Close did not wake putter")
+		}
+	})
+
+	t.Run("take drains after close", func(t *testing.T) {
+		bq := NewBlocking[int](0)
+		bq.Put(1)
+		bq.Put(2)
+		bq.Close()
+
+		if !bq.IsClosed() {
+			t.Error("Expected queue to be closed")
+		}
+		if err := bq.Put(3); !errors.Is(err, errs.ErrClosed) {
+			t.Errorf("Expected ErrClosed from Put, got %v", err)
+		}
+		if bq.Offer(3, 0) {
+			t.Error("Expected Offer on closed queue to fail")
+		}
+		for _, want := range []int{1, 2} {
+			if value, err := bq.Take(); err != nil || value != want {
+				t.Errorf("Expected (%d, nil), got (%d, %v)", want, value, err)
+			}
+		}
+		if _, err := bq.Take(); !errors.Is(err, errs.ErrClosed) {
+			t.Errorf("Expected ErrClosed after draining, got %v", err)
+		}
+	})
+}
+
+func TestBlockingQueueConcurrent(t *testing.T) {
+	const producers = 8
+	const consumers = 8
+	const perProducer = 2000
+
+	bq := NewBlocking[int](16)
+	var producersWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producersWg.Add(1)
+		go func(p int) {
+			defer producersWg.Done()
+			for i := 0; i < perProducer; i++ {
+				if err := bq.Put(p*perProducer + i); err != nil {
+					t.Errorf("Unexpected Put error: %v", err)
+					return
+				}
+			}
+		}(p)
+	}
+
+	results := make(chan []int, consumers)
+	var consumersWg sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		consumersWg.Add(1)
+		go func() {
+			defer consumersWg.Done()
+			var got []int
+			for {
+				value, err := bq.Take()
+				if err != nil {
+					break
+				}
+				if bq.Size() > bq.Capacity() {
+					t.Errorf("Size %d exceeded capacity %d", bq.Size(), bq.Capacity())
+				}
+				got = append(got, value)
+			}
+			results <- got
+		}()
+	}
+
+	producersWg.Wait()
+	bq.Close()
+	consumersWg.Wait()
+	close(results)
+
+	var all []int
+	for got := range results {
+		all = append(all, got...)
+	}
+	sort.Ints(all)
+	if len(all) != producers*perProducer {
+		t.Fatalf("Expected %d values, got %d", producers*perProducer, len(all))
+	}
+	for i, v := range all {
+		if v != i {
+			t.Fatalf("Expected every value exactly once, mismatch at %d: %d", i, v)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thefrost13/gollections/errs"
+)
+
+// OverflowPolicy selects what a BoundedQueue does when an element is put while it is full.
+type OverflowPolicy int
+
+const (
+	// Reject refuses the new element and Put returns errs.ErrFull.
+	Reject OverflowPolicy = iota
+	// DropOldest discards the front element to make room for the new one.
+	DropOldest
+	// DropNewest discards the new element and Put reports success.
+	DropNewest
+	// Block makes Put wait until a consumer frees space.
+	Block
+)
+
+// String returns the policy name, suitable as a metrics label.
+func (p OverflowPolicy) String() string {
+	switch p {
+	case Reject:
+		return "reject"
+	case DropOldest:
+		return "drop-oldest"
+	case DropNewest:
+		return "drop-newest"
+	case Block:
+		return "block"
+	default:
+		return fmt.Sprintf("OverflowPolicy(%d)", int(p))
+	}
+}
+
+// BoundedStats holds the overflow counters of a BoundedQueue.
+type BoundedStats struct {
+	Dropped  uint64 // number of elements discarded by the DropOldest or DropNewest policy
+	Rejected uint64 // number of Put calls that failed because the queue stayed full
+}
+
+// BoundedQueue is a FIFO queue with a fixed capacity that is safe for concurrent use.
+// When it is full, Put applies the queue's OverflowPolicy, and every element the policy
+// discards or refuses is counted in Stats so that losses can be reported as metrics.
+// It is built on BlockingQueue: the Block policy waits exactly like BlockingQueue.Put,
+// and Take waits for an element like BlockingQueue.Take whatever the policy.
+// After Close, Put fails with errs.ErrClosed, blocked putters and takers are woken, and the
+// remaining elements can still be dequeued.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type BoundedQueue[T any] struct {
+	blocking *BlockingQueue[T] // the buffered elements, capacity and closed state; its mutex also guards stats
+	policy   OverflowPolicy    // what Put does when the queue is full
+	stats    BoundedStats      // drop and reject counters
+}
+
+// NewBounded creates and returns a new empty BoundedQueue holding at most capacity elements.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - capacity: the maximum number of buffered elements, must be positive
+//   - policy: what Put does when the queue is full
+//
+// Returns:
+//   - a new empty BoundedQueue
+//
+// Panics if capacity is zero or negative.
+//
+// Example:
+//
+//	events := NewBounded[Event](1024, DropOldest)
+//	events.Put(event)  // never blocks; the oldest event is dropped when full
+func NewBounded[T any](capacity int, policy OverflowPolicy) *BoundedQueue[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("queue: NewBounded: capacity %d must be positive", capacity))
+	}
+	if policy < Reject || policy > Block {
+		panic(fmt.Sprintf("queue: NewBounded: unknown policy %v", policy))
+	}
+	return &BoundedQueue[T]{
+		blocking: NewBlocking[T](capacity),
+		policy:   policy,
+	}
+}
+
+// Put adds an element to the back of the queue, applying the overflow policy if it is full.
+// Only the Block policy can make Put wait.
+// Time complexity: O(1) once space is available.
+//
+// Parameters:
+//   - value: the element to add
+//
+// Returns:
+//   - nil if the element was added or dropped by DropNewest, errs.ErrFull if the Reject policy
+//     refused it, or errs.ErrClosed if the queue is closed
+//
+// Example:
+//
+//	if err := events.Put(event); errors.Is(err, errs.ErrFull) {
+//	    log.Println("telemetry buffer full")
+//	}
+func (bq *BoundedQueue[T]) Put(value T) error {
+	return bq.PutContext(context.Background(), value)
+}
+
+// PutContext adds an element to the back of the queue, applying the overflow policy if it is full.
+// Under the Block policy it waits for space until ctx is cancelled or its deadline passes;
+// the other policies never wait and ignore ctx.
+// Time complexity: O(1) once space is available.
+//
+// Parameters:
+//   - ctx: controls how long the Block policy waits for space
+//   - value: the element to add
+//
+// Returns:
+//   - nil if the element was added or dropped by DropNewest, errs.ErrFull if the Reject policy
+//     refused it, ctx.Err() if ctx ends first, or errs.ErrClosed if the queue is closed
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+//	defer cancel()
+//	err := events.PutContext(ctx, event)
+func (bq *BoundedQueue[T]) PutContext(ctx context.Context, value T) error {
+	b := bq.blocking
+	if bq.policy == Block {
+		err := b.PutContext(ctx, value)
+		if err != nil && !errors.Is(err, errs.ErrClosed) {
+			b.mu.Lock()
+			bq.stats.Rejected++
+			b.mu.Unlock()
+		}
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return errs.ErrClosed
+	}
+	if b.queue.Size() < b.capacity {
+		b.queue.Enqueue(value)
+		b.wakeTakers()
+		return nil
+	}
+	switch bq.policy {
+	case Reject:
+		bq.stats.Rejected++
+		return errs.ErrFull
+	case DropOldest:
+		b.queue.TryDequeue()
+		b.queue.Enqueue(value)
+	}
+	bq.stats.Dropped++
+	return nil
+}
+
+// Take removes and returns the front element, blocking while the queue is empty.
+// Time complexity: O(1) once an element is available.
+//
+// Returns:
+//   - value: the front element, or zero value on error
+//   - err: nil on success, or errs.ErrClosed if the queue is closed and drained
+//
+// Example:
+//
+//	for {
+//	    event, err := events.Take()
+//	    if err != nil {
+//	        return  // queue closed and drained
+//	    }
+//	    exporter.Send(event)
+//	}
+func (bq *BoundedQueue[T]) Take() (T, error) {
+	return bq.blocking.Take()
+}
+
+// TakeContext removes and returns the front element, blocking while the queue is empty
+// until ctx is cancelled or its deadline passes.
+// Time complexity: O(1) once an element is available.
+//
+// Parameters:
+//   - ctx: controls how long to wait for an element
+//
+// Returns:
+//   - value: the front element, or zero value on error
+//   - err: nil on success, ctx.Err() if ctx ends first, or errs.ErrClosed if the queue is closed and drained
+func (bq *BoundedQueue[T]) TakeContext(ctx context.Context) (T, error) {
+	return bq.blocking.TakeContext(ctx)
+}
+
+// TryDequeue removes and returns the front element without blocking.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the front element, or zero value if the queue is empty
+//   - ok: true if an element was removed, false otherwise
+func (bq *BoundedQueue[T]) TryDequeue() (T, bool) {
+	b := bq.blocking
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	value, ok := b.queue.TryDequeue()
+	if ok {
+		b.wakePutters()
+	}
+	return value, ok
+}
+
+// TryPeek returns the front element without removing it.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the front element, or zero value if the queue is empty
+//   - ok: true if the queue is not empty, false otherwise
+func (bq *BoundedQueue[T]) TryPeek() (T, bool) {
+	return bq.blocking.Peek()
+}
+
+// DequeueAll removes and returns every element in FIFO order as one atomic step,
+// which suits flushing a buffer in batches.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - the removed elements, or an empty slice if the queue was empty
+//
+// Example:
+//
+//	for range ticker.C {
+//	    exporter.Send(events.DequeueAll())
+//	}
+func (bq *BoundedQueue[T]) DequeueAll() []T {
+	b := bq.blocking
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	items := b.queue.ToSlice()
+	b.queue.Clear()
+	b.wakePutters()
+	return items
+}
+
+// Stats returns a copy of the drop and reject counters.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the current statistics
+//
+// Example:
+//
+//	s := events.Stats()
+//	droppedEvents.Add(float64(s.Dropped))
+func (bq *BoundedQueue[T]) Stats() BoundedStats {
+	bq.blocking.mu.Lock()
+	defer bq.blocking.mu.Unlock()
+	return bq.stats
+}
+
+// ResetStats sets the drop and reject counters back to zero and returns their previous values,
+// so that periodic reporting does not miss drops that happen in between.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the statistics before the reset
+func (bq *BoundedQueue[T]) ResetStats() BoundedStats {
+	bq.blocking.mu.Lock()
+	defer bq.blocking.mu.Unlock()
+	stats := bq.stats
+	bq.stats = BoundedStats{}
+	return stats
+}
+
+// Close marks the queue as closed and wakes every blocked Put and Take.
+// Elements already in the queue can still be dequeued. Calling Close more than once is a no-op.
+// Time complexity: O(1).
+func (bq *BoundedQueue[T]) Close() {
+	bq.blocking.Close()
+}
+
+// IsClosed returns true if Close has been called.
+// Time complexity: O(1).
+func (bq *BoundedQueue[T]) IsClosed() bool {
+	return bq.blocking.IsClosed()
+}
+
+// Policy returns the overflow policy the queue was created with.
+// Time complexity: O(1).
+func (bq *BoundedQueue[T]) Policy() OverflowPolicy {
+	return bq.policy
+}
+
+// Capacity returns the maximum number of elements the queue can hold.
+// Time complexity: O(1).
+func (bq *BoundedQueue[T]) Capacity() int {
+	return bq.blocking.Capacity()
+}
+
+// Size returns the number of elements in the queue.
+// Time complexity: O(1).
+func (bq *BoundedQueue[T]) Size() int {
+	return bq.blocking.Size()
+}
+
+// IsEmpty returns true if the queue contains no elements.
+// Time complexity: O(1).
+func (bq *BoundedQueue[T]) IsEmpty() bool {
+	return bq.blocking.IsEmpty()
+}
+
+// IsFull returns true if the queue holds capacity elements.
+// Time complexity: O(1).
+func (bq *BoundedQueue[T]) IsFull() bool {
+	return bq.blocking.Size() == bq.blocking.Capacity()
+}
+
+// ToSlice returns a slice containing all elements in the queue in FIFO order.
+// Time complexity: O(n) where n is the number of elements.
+func (bq *BoundedQueue[T]) ToSlice() []T {
+	return bq.blocking.ToSlice()
+}
+
+// Clear removes all elements from the queue and wakes any blocked Put.
+// The statistics are not reset.
+// Time complexity: O(1).
+func (bq *BoundedQueue[T]) Clear() {
+	bq.blocking.Clear()
+}
@@ -0,0 +1,351 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thefrost13/gollections/errs"
+)
+
+func TestNewBounded(t *testing.T) {
+	bq := NewBounded[int](3, DropOldest)
+	if bq.Capacity() != 3 || bq.Policy() != DropOldest {
+		t.Errorf("Expected capacity 3 and drop-oldest, got %d and %v", bq.Capacity(), bq.Policy())
+	}
+	if !bq.IsEmpty() || bq.IsFull() || bq.IsClosed() || bq.Size() != 0 {
+		t.Error("Expected new queue to be empty and open")
+	}
+
+	for _, tc := range []struct {
+		name     string
+		capacity int
+		policy   OverflowPolicy
+	}{
+		{"zero capacity", 0, Reject},
+		{"negative capacity", -1, Reject},
+		{"unknown policy", 1, OverflowPolicy(42)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected NewBounded to panic")
+				}
+			}()
+			NewBounded[int](tc.capacity, tc.policy)
+		})
+	}
+}
+
+func TestOverflowPolicyString(t *testing.T) {
+	expected := map[OverflowPolicy]string{
+		Reject:             "reject",
+		DropOldest:         "drop-oldest",
+		DropNewest:         "drop-newest",
+		Block:              "block",
+		OverflowPolicy(-1): "OverflowPolicy(-1)",
+	}
+	for policy, name := range expected {
+		if policy.String() != name {
+			t.Errorf("Expected %q, got %q", name, policy.String())
+		}
+	}
+}
+
+func TestBoundedQueuePolicies(t *testing.T) {
+	tests := []struct {
+		policy   OverflowPolicy
+		err      error
+		contents []int
+		stats    BoundedStats
+	}{
+		{Reject, errs.ErrFull, []int{1, 2, 3}, BoundedStats{Rejected: 2}},
+		{DropOldest, nil, []int{3, 4, 5}, BoundedStats{Dropped: 2}},
+		{DropNewest, nil, []int{1, 2, 3}, BoundedStats{Dropped: 2}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.policy.String(), func(t *testing.T) {
+			bq := NewBounded[int](3, tc.policy)
+			for i := 1; i <= 3; i++ {
+				if err := bq.Put(i); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+			if !bq.IsFull() {
+				t.Error("Expected queue to be full")
+			}
+			for i := 4; i <= 5; i++ {
+				if err := bq.Put(i); !errors.Is(err, tc.err) {
+					t.Errorf("Expected %v, got %v", tc.err, err)
+				}
+			}
+			if !reflect.DeepEqual(bq.ToSlice(), tc.contents) {
+				t.Errorf("Expected %v, got %v", tc.contents, bq.ToSlice())
+			}
+			if bq.Stats() != tc.stats {
+				t.Errorf("Expected stats %+v, got %+v", tc.stats, bq.Stats())
+			}
+			if value, ok := bq.TryPeek(); !ok || value != tc.contents[0] {
+				t.Errorf("Expected peek (%d, true), got (%d, %t)", tc.contents[0], value, ok)
+			}
+		})
+	}
+}
+
+func TestBoundedQueueBlock(t *testing.T) {
+	t.Run("put waits for space", func(t *testing.T) {
+		bq := NewBounded[int](1, Block)
+		bq.Put(1)
+		done := make(chan error)
+		go func() {
+			done <- bq.Put(2)
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("Put returned while queue was full")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		if value, ok := bq.TryDequeue(); !ok || value != 1 {
+			t.Errorf("Expected (1, true), got (%d, %t)", value, ok)
+		}
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Put did not wake after TryDequeue")
+		}
+		if !reflect.DeepEqual(bq.ToSlice(), []int{2}) {
+			t.Errorf("Expected [2], got %v", bq.ToSlice())
+		}
+	})
+
+	t.Run("deadline counts as rejected", func(t *testing.T) {
+		bq := NewBounded[int](1, Block)
+		bq.Put(1)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if err := bq.PutContext(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+		if bq.Stats() != (BoundedStats{Rejected: 1}) {
+			t.Errorf("Expected one rejection, got %+v", bq.Stats())
+		}
+	})
+
+	t.Run("close wakes putters", func(t *testing.T) {
+		bq := NewBounded[int](1, Block)
+		bq.Put(1)
+		done := make(chan error)
+		go func() {
+			done <- bq.Put(2)
+		}()
+		time.Sleep(10 * time.Millisecond)
+		bq.Close()
+		select {
+		case err := <-done:
+			if !errors.Is(err, errs.ErrClosed) {
+				t.Errorf("Expected errs.ErrClosed, got %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Put did not wake after Close")
+		}
+		if value, ok := bq.TryDequeue(); !ok || value != 1 {
+			t.Errorf("Expected remaining element 1, got (%d, %t)", value, ok)
+		}
+	})
+
+	t.Run("clear and dequeue all wake putters", func(t *testing.T) {
+		for _, free := range []func(*BoundedQueue[int]){
+			(*BoundedQueue[int]).Clear,
+			func(bq *BoundedQueue[int]) { bq.DequeueAll() },
+		} {
+			bq := NewBounded[int](1, Block)
+			bq.Put(1)
+			done := make(chan error)
+			go func() {
+				done <- bq.Put(2)
+			}()
+			time.Sleep(10 * time.Millisecond)
+			free(bq)
+			select {
+			case err := <-done:
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("Put did not wake after freeing space")
+			}
+		}
+	})
+}
+
+func TestBoundedQueueTake(t *testing.T) {
+	t.Run("take waits for put", func(t *testing.T) {
+		bq := NewBounded[int](2, DropOldest)
+		result := make(chan int)
+		go func() {
+			value, _ := bq.Take()
+			result <- value
+		}()
+		time.Sleep(10 * time.Millisecond)
+		bq.Put(7)
+		select {
+		case value := <-result:
+			if value != 7 {
+				t.Errorf("Expected 7, got %d", value)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Take did not wake after Put")
+		}
+	})
+
+	t.Run("take frees space for blocked put", func(t *testing.T) {
+		bq := NewBounded[int](1, Block)
+		bq.Put(1)
+		done := make(chan error)
+		go func() {
+			done <- bq.Put(2)
+		}()
+		time.Sleep(10 * time.Millisecond)
+		if value, err := bq.Take(); err != nil || value != 1 {
+			t.Errorf("Expected (1, nil), got (%d, %v)", value, err)
+		}
+		if err := <-done; err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("context ends", func(t *testing.T) {
+		bq := NewBounded[int](1, Reject)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if _, err := bq.TakeContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("close drains then fails", func(t *testing.T) {
+		bq := NewBounded[int](2, DropNewest)
+		bq.Put(1)
+		bq.Close()
+		if value, err := bq.Take(); err != nil || value != 1 {
+			t.Errorf("Expected (1, nil), got (%d, %v)", value, err)
+		}
+		if _, err := bq.Take(); !errors.Is(err, errs.ErrClosed) {
+			t.Errorf("Expected errs.ErrClosed, got %v", err)
+		}
+	})
+
+	t.Run("close wakes takers", func(t *testing.T) {
+		bq := NewBounded[int](1, Block)
+		done := make(chan error)
+		go func() {
+			_, err := bq.Take()
+			done <- err
+		}()
+		time.Sleep(10 * time.Millisecond)
+		bq.Close()
+		select {
+		case err := <-done:
+			if !errors.Is(err, errs.ErrClosed) {
+				t.Errorf("Expected errs.ErrClosed, got %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Take did not wake after Close")
+		}
+	})
+}
+
+func TestBoundedQueueOperations(t *testing.T) {
+	bq := NewBounded[string](4, DropOldest)
+	if _, ok := bq.TryDequeue(); ok {
+		t.Error("Expected TryDequeue on empty queue to fail")
+	}
+	if _, ok := bq.TryPeek(); ok {
+		t.Error("Expected TryPeek on empty queue to fail")
+	}
+
+	for _, s := range []string{"a", "b", "c", "d", "e"} {
+		bq.Put(s)
+	}
+	if items := bq.DequeueAll(); !reflect.DeepEqual(items, []string{"b", "c", "d", "e"}) {
+		t.Errorf("Expected [b c d e], got %v", items)
+	}
+	if items := bq.DequeueAll(); len(items) != 0 {
+		t.Errorf("Expected no elements, got %v", items)
+	}
+
+	if stats := bq.ResetStats(); stats.Dropped != 1 {
+		t.Errorf("Expected 1 drop before reset, got %+v", stats)
+	}
+	if bq.Stats() != (BoundedStats{}) {
+		t.Errorf("Expected zero stats after reset, got %+v", bq.Stats())
+	}
+
+	bq.Put("x")
+	bq.Clear()
+	if !bq.IsEmpty() {
+		t.Error("Expected queue to be empty after Clear")
+	}
+
+	bq.Close()
+	bq.Close()
+	if !bq.IsClosed() {
+		t.Error("Expected queue to be closed")
+	}
+	if err := bq.Put("y"); !errors.Is(err, errs.ErrClosed) {
+		t.Errorf("Expected errs.ErrClosed, got %v", err)
+	}
+}
+
+func TestBoundedQueueConcurrent(t *testing.T) {
+	const producers = 8
+	const perProducer = 500
+
+	bq := NewBounded[int](16, DropOldest)
+	var wg sync.WaitGroup
+	received := 0
+	stop := make(chan struct{})
+	consumed := make(chan struct{})
+
+	go func() {
+		defer close(consumed)
+		for {
+			select {
+			case <-stop:
+				received += len(bq.DequeueAll())
+				return
+			default:
+				received += len(bq.DequeueAll())
+			}
+		}
+	}()
+
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				bq.Put(i)
+			}
+		}()
+	}
+	wg.Wait()
+	close(stop)
+	<-consumed
+
+	if bq.Size() > bq.Capacity() {
+		t.Errorf("Expected size at most %d, got %d", bq.Capacity(), bq.Size())
+	}
+	if total := uint64(received) + bq.Stats().Dropped; total != producers*perProducer {
+		t.Errorf("Expected received + dropped = %d, got %d", producers*perProducer, total)
+	}
+}
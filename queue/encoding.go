@@ -0,0 +1,140 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON implements json.Marshaler, encoding the queue as a JSON array in FIFO order.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - the JSON encoding of the queue
+//   - an error if an element cannot be marshaled
+//
+// Example:
+//
+//	data, _ := json.Marshal(New([]int{1, 2, 3}))  // [1,2,3], 1 at the front
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.ToSlice())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It replaces the contents of the queue with the elements of a JSON array, the first element
+// becoming the front. A JSON null leaves the queue unchanged, and if an error occurs the queue
+// is left unchanged.
+// Time complexity: O(n) where n is the number of array elements.
+//
+// Parameters:
+//   - data: the JSON encoding of an array
+//
+// Returns:
+//   - an error if data is not an array of T
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	elements, err := unmarshalJSONElements[T](data)
+	if err != nil || elements == nil {
+		return err
+	}
+	*q = *New(elements)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using encoding/gob.
+// encoding/gob uses it as well, so a Queue can be sent with a gob.Encoder directly.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - the binary encoding of the queue
+//   - an error if an element cannot be encoded by gob
+func (q *Queue[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinaryElements(q.ToSlice())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the queue
+// with the elements decoded from data. If an error occurs the queue is left unchanged.
+// Time complexity: O(n) where n is the number of encoded elements.
+//
+// Parameters:
+//   - data: the output of MarshalBinary
+//
+// Returns:
+//   - an error if data is not a valid encoding
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	elements, err := unmarshalBinaryElements[T](data)
+	if err != nil {
+		return err
+	}
+	*q = *New(elements)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the queue as a JSON array in FIFO order.
+// The encoding is the same as Queue's, so the two types can decode each other's output.
+// Time complexity: O(n) where n is the number of elements.
+func (q *RingQueue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.ToSlice())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of the queue with the
+// elements of a JSON array. A JSON null leaves the queue unchanged, and if an error occurs
+// the queue is left unchanged.
+// Time complexity: O(n) where n is the number of array elements.
+func (q *RingQueue[T]) UnmarshalJSON(data []byte) error {
+	elements, err := unmarshalJSONElements[T](data)
+	if err != nil || elements == nil {
+		return err
+	}
+	*q = *NewRing(elements)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using encoding/gob.
+// The encoding is the same as Queue's, so the two types can decode each other's output.
+// Time complexity: O(n) where n is the number of elements.
+func (q *RingQueue[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinaryElements(q.ToSlice())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the queue
+// with the elements decoded from data. If an error occurs the queue is left unchanged.
+// Time complexity: O(n) where n is the number of encoded elements.
+func (q *RingQueue[T]) UnmarshalBinary(data []byte) error {
+	elements, err := unmarshalBinaryElements[T](data)
+	if err != nil {
+		return err
+	}
+	*q = *NewRing(elements)
+	return nil
+}
+
+// unmarshalJSONElements decodes a JSON array of T. It returns a nil slice without error
+// for a JSON null, and a non-nil slice for any array, including an empty one.
+func unmarshalJSONElements[T any](data []byte) ([]T, error) {
+	if string(data) == "null" {
+		return nil, nil
+	}
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return nil, err
+	}
+	return elements, nil
+}
+
+// marshalBinaryElements gob-encodes elements.
+func marshalBinaryElements[T any](elements []T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(elements); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// unmarshalBinaryElements decodes the output of marshalBinaryElements.
+func unmarshalBinaryElements[T any](data []byte) ([]T, error) {
+	var elements []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements); err != nil {
+		return nil, err
+	}
+	return elements, nil
+}
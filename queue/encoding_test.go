@@ -0,0 +1,207 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestQueueJSON(t *testing.T) {
+	t.Run("Marshal in FIFO order", func(t *testing.T) {
+		q := New([]int{1, 2, 3})
+		data, err := json.Marshal(q)
+		if err != nil || string(data) != "[1,2,3]" {
+			t.Errorf("Expected [1,2,3], got %s (%v)", data, err)
+		}
+		data, err = json.Marshal(New[int](nil))
+		if err != nil || string(data) != "[]" {
+			t.Errorf("Expected [], got %s (%v)", data, err)
+		}
+	})
+
+	t.Run("Unmarshal replaces contents", func(t *testing.T) {
+		q := New([]string{"old"})
+		if err := json.Unmarshal([]byte(`["a","b"]`), q); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(q.ToSlice(), []string{"a", "b"}) {
+			t.Errorf("Expected [a b], got %v", q.ToSlice())
+		}
+		if err := json.Unmarshal([]byte(`[]`), q); err != nil || !q.IsEmpty() {
+			t.Errorf("Expected empty queue, got %v (%v)", q.ToSlice(), err)
+		}
+	})
+
+	t.Run("Unmarshal into struct field", func(t *testing.T) {
+		var doc struct {
+			Jobs *Queue[string] `json:"jobs"`
+		}
+		if err := json.Unmarshal([]byte(`{"jobs":["x","y"]}`), &doc); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if doc.Jobs.Dequeue() != "x" {
+			t.Error("Expected x at the front")
+		}
+	})
+
+	t.Run("null and errors leave the queue unchanged", func(t *testing.T) {
+		q := New([]int{1})
+		if err := q.UnmarshalJSON([]byte("null")); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if err := json.Unmarshal([]byte(`[true]`), q); err == nil {
+			t.Error("Expected error for wrong element type")
+		}
+		if !reflect.DeepEqual(q.ToSlice(), []int{1}) {
+			t.Errorf("Expected [1], got %v", q.ToSlice())
+		}
+	})
+
+	t.Run("Queue and RingQueue share an encoding", func(t *testing.T) {
+		data, _ := json.Marshal(New([]int{1, 2, 3}))
+		ring := NewRing[int](nil)
+		if err := json.Unmarshal(data, ring); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(ring.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", ring.ToSlice())
+		}
+
+		data, _ = ring.MarshalBinary()
+		linked := New[int](nil)
+		if err := linked.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(linked.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", linked.ToSlice())
+		}
+	})
+}
+
+func TestQueueBinary(t *testing.T) {
+	t.Run("gob encoder uses the binary encoding", func(t *testing.T) {
+		type doc struct {
+			Name  string
+			Queue *Queue[int]
+			Ring  *RingQueue[int]
+		}
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(doc{"q", New([]int{1, 2}), NewRing([]int{3, 4})}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var decoded doc
+		if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(decoded.Queue.ToSlice(), []int{1, 2}) || !reflect.DeepEqual(decoded.Ring.ToSlice(), []int{3, 4}) {
+			t.Errorf("Unexpected decoded value %v and %v", decoded.Queue.ToSlice(), decoded.Ring.ToSlice())
+		}
+	})
+
+	t.Run("invalid data leaves the queue unchanged", func(t *testing.T) {
+		q := New([]int{1})
+		if err := q.UnmarshalBinary([]byte("junk")); err == nil {
+			t.Error("Expected error for invalid data")
+		}
+		if !reflect.DeepEqual(q.ToSlice(), []int{1}) {
+			t.Errorf("Expected [1], got %v", q.ToSlice())
+		}
+	})
+}
+
+func TestSyncQueueEncoding(t *testing.T) {
+	q := NewSync(New([]int{1, 2}))
+	data, err := json.Marshal(q)
+	if err != nil || string(data) != "[1,2]" {
+		t.Errorf("Expected [1,2], got %s (%v)", data, err)
+	}
+	var decoded SyncQueue[int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.ToSlice(), []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", decoded.ToSlice())
+	}
+
+	data, err = q.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var fromBinary SyncQueue[int]
+	if err := fromBinary.UnmarshalBinary(data); err != nil || fromBinary.Peek() != 1 {
+		t.Errorf("Expected 1 at the front, got %d (%v)", fromBinary.Peek(), err)
+	}
+}
+
+func TestSyncQueueZeroValueEncoding(t *testing.T) {
+	var zero SyncQueue[int]
+	data, err := json.Marshal(&zero)
+	if err != nil || string(data) != "[]" {
+		t.Errorf("Expected [], got %s (%v)", data, err)
+	}
+	data, err = zero.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var fromBinary SyncQueue[int]
+	if err := fromBinary.UnmarshalBinary(data); err != nil || !fromBinary.IsEmpty() {
+		t.Errorf("Expected empty queue, got size %d (%v)", fromBinary.Size(), err)
+	}
+}
+
+func FuzzQueueRoundTrip(f *testing.F) {
+	f.Add([]byte{}, 0)
+	f.Add([]byte{1, 2, 3}, 1)
+	f.Add([]byte("a longer seed that wraps the ring buffer"), 7)
+
+	f.Fuzz(func(t *testing.T, data []byte, dequeues int) {
+		q := New[int](nil)
+		ring := NewRing[int](nil)
+		for _, b := range data {
+			q.Enqueue(int(int8(b)) * 1000)
+			ring.Enqueue(int(int8(b)) * 1000)
+		}
+		// Dequeue a few so the ring buffer's head is not at index 0.
+		for i := 0; i < dequeues%8 && !q.IsEmpty(); i++ {
+			q.Dequeue()
+			ring.Dequeue()
+		}
+		expected := q.ToSlice()
+
+		for _, src := range []interface {
+			MarshalJSON() ([]byte, error)
+			MarshalBinary() ([]byte, error)
+		}{q, ring} {
+			encoded, err := src.MarshalJSON()
+			if err != nil {
+				t.Fatalf("MarshalJSON: %v", err)
+			}
+			fromJSON := New[int](nil)
+			if err := json.Unmarshal(encoded, fromJSON); err != nil {
+				t.Fatalf("UnmarshalJSON(%s): %v", encoded, err)
+			}
+			if !slices.Equal(fromJSON.ToSlice(), expected) {
+				t.Errorf("JSON round trip: expected %v, got %v", expected, fromJSON.ToSlice())
+			}
+
+			encoded, err = src.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary: %v", err)
+			}
+			fromBinary := NewRing[int](nil)
+			if err := fromBinary.UnmarshalBinary(encoded); err != nil {
+				t.Fatalf("UnmarshalBinary: %v", err)
+			}
+			if !slices.Equal(fromBinary.ToSlice(), expected) {
+				t.Errorf("Binary round trip: expected %v, got %v", expected, fromBinary.ToSlice())
+			}
+		}
+
+		// Arbitrary input must never panic.
+		_ = New[int](nil).UnmarshalJSON(data)
+		_ = NewRing[int](nil).UnmarshalBinary(data)
+	})
+}
@@ -0,0 +1,173 @@
+package queue
+
+import "sync/atomic"
+
+// lockFreeNode is a node of a LockFreeQueue. Its value is written before the node is published
+// and never modified afterwards; only next changes, atomically.
+type lockFreeNode[T any] struct {
+	value T                               // the element, unused in the sentinel
+	next  atomic.Pointer[lockFreeNode[T]] // the next node toward the back, nil for the last node
+}
+
+// LockFreeQueue is a multi-producer, multi-consumer FIFO queue that is safe for concurrent use without locks.
+// It is a Michael-Scott queue: a singly linked list with a sentinel node at the front, where producers
+// append with a compare-and-swap on the last node's next pointer and consumers advance the head with a
+// compare-and-swap. A goroutine that finds the tail lagging behind helps to advance it, so a stalled
+// goroutine never blocks the others.
+//
+// Every element gets a fresh node and the garbage collector does not reuse a node while any goroutine
+// still refers to it, which rules out the ABA problem. The most recently dequeued element stays
+// referenced by the sentinel until the next dequeue.
+// A LockFreeQueue must be created with NewLockFree.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type LockFreeQueue[T any] struct {
+	head     atomic.Pointer[lockFreeNode[T]] // the sentinel; the front element is head.next
+	tail     atomic.Pointer[lockFreeNode[T]] // the last node, or briefly the one before it
+	size     atomic.Int64                    // number of elements, counting enqueues in progress
+	capacity int64                           // maximum number of elements, or 0 for unbounded
+}
+
+// NewLockFree creates and returns a new empty LockFreeQueue holding at most capacity elements.
+// A capacity of zero or less makes the queue unbounded, so TryEnqueue always succeeds.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - capacity: the maximum number of elements, or <= 0 for unbounded
+//
+// Returns:
+//   - a new empty LockFreeQueue
+//
+// Example:
+//
+//	events := NewLockFree[Event](0)
+//	go func() { events.TryEnqueue(Event{Name: "start"}) }()
+//	if event, ok := events.TryDequeue(); ok {
+//	    handle(event)
+//	}
+func NewLockFree[T any](capacity int) *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{capacity: int64(max(capacity, 0))}
+	sentinel := &lockFreeNode[T]{}
+	q.head.Store(sentinel)
+	q.tail.Store(sentinel)
+	return q
+}
+
+// TryEnqueue adds an element to the back of the queue unless a bounded queue is full.
+// Time complexity: O(1), retried while other goroutines change the queue concurrently.
+//
+// Parameters:
+//   - value: the element to add to the queue
+//
+// Returns:
+//   - true if the element was added, false if the queue was full
+func (q *LockFreeQueue[T]) TryEnqueue(value T) bool {
+	if !q.reserve() {
+		return false
+	}
+	n := &lockFreeNode[T]{value: value}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			q.tail.CompareAndSwap(tail, next) // help a producer that has linked but not swung the tail
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, n) {
+			q.tail.CompareAndSwap(tail, n)
+			return true
+		}
+	}
+}
+
+// TryDequeue removes and returns the element at the front of the queue.
+// Time complexity: O(1), retried while other goroutines change the queue concurrently.
+//
+// Returns:
+//   - value: the front element, or zero value if the queue is empty
+//   - ok: true if an element was removed, false if the queue was empty
+//
+// Example:
+//
+//	for event, ok := events.TryDequeue(); ok; event, ok = events.TryDequeue() {
+//	    handle(event)
+//	}
+func (q *LockFreeQueue[T]) TryDequeue() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zero T
+			return zero, false
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next) // the tail lags behind a linked node
+			continue
+		}
+		value := next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.size.Add(-1)
+			return value, true
+		}
+	}
+}
+
+// TryPeek returns the element at the front of the queue without removing it.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the front element, or zero value if the queue is empty
+//   - ok: true if the queue was not empty, false otherwise
+func (q *LockFreeQueue[T]) TryPeek() (T, bool) {
+	if next := q.head.Load().next.Load(); next != nil {
+		return next.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Size returns the number of elements in the queue.
+// Under concurrent use the count is approximate: it includes enqueues that have reserved space
+// but not yet linked their element, and dequeues that have unlinked an element but not yet been counted.
+// Time complexity: O(1).
+func (q *LockFreeQueue[T]) Size() int {
+	return int(q.size.Load())
+}
+
+// IsEmpty returns true if the queue contains no elements.
+// Time complexity: O(1).
+func (q *LockFreeQueue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
+
+// Capacity returns the maximum number of elements, or 0 if the queue is unbounded.
+// Time complexity: O(1).
+func (q *LockFreeQueue[T]) Capacity() int {
+	return int(q.capacity)
+}
+
+// reserve counts a new element, failing if a bounded queue is full.
+// Counting before linking keeps the number of linked elements within the capacity.
+func (q *LockFreeQueue[T]) reserve() bool {
+	if q.capacity == 0 {
+		q.size.Add(1)
+		return true
+	}
+	for {
+		n := q.size.Load()
+		if n >= q.capacity {
+			return false
+		}
+		if q.size.CompareAndSwap(n, n+1) {
+			return true
+		}
+	}
+}
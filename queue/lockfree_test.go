@@ -0,0 +1,211 @@
+package queue
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLockFreeQueueOperations(t *testing.T) {
+	t.Run("FIFO order", func(t *testing.T) {
+		q := NewLockFree[int](0)
+		if !q.IsEmpty() || q.Size() != 0 || q.Capacity() != 0 {
+			t.Error("Expected new unbounded queue to be empty")
+		}
+		if _, ok := q.TryDequeue(); ok {
+			t.Error("Expected TryDequeue on empty queue to fail")
+		}
+		if _, ok := q.TryPeek(); ok {
+			t.Error("Expected TryPeek on empty queue to fail")
+		}
+
+		for i := 1; i <= 3; i++ {
+			if !q.TryEnqueue(i) {
+				t.Fatalf("Expected TryEnqueue(%d) to succeed", i)
+			}
+		}
+		if v, ok := q.TryPeek(); !ok || v != 1 {
+			t.Errorf("Expected (1, true), got (%d, %t)", v, ok)
+		}
+		if q.Size() != 3 {
+			t.Errorf("Expected size 3, got %d", q.Size())
+		}
+		for i := 1; i <= 3; i++ {
+			if v, ok := q.TryDequeue(); !ok || v != i {
+				t.Errorf("Expected (%d, true), got (%d, %t)", i, v, ok)
+			}
+		}
+		if !q.IsEmpty() || q.Size() != 0 {
+			t.Error("Expected queue to be empty")
+		}
+	})
+
+	t.Run("Bounded queue", func(t *testing.T) {
+		q := NewLockFree[string](2)
+		if q.Capacity() != 2 {
+			t.Errorf("Expected capacity 2, got %d", q.Capacity())
+		}
+		if !q.TryEnqueue("a") || !q.TryEnqueue("b") {
+			t.Fatal("Expected enqueues within capacity to succeed")
+		}
+		if q.TryEnqueue("c") {
+			t.Error("Expected TryEnqueue on full queue to fail")
+		}
+		q.TryDequeue()
+		if !q.TryEnqueue("c") {
+			t.Error("Expected TryEnqueue to succeed after a dequeue")
+		}
+		if v, _ := q.TryDequeue(); v != "b" {
+			t.Errorf("Expected b, got %s", v)
+		}
+	})
+
+	t.Run("Negative capacity is unbounded", func(t *testing.T) {
+		q := NewLockFree[int](-1)
+		for i := 0; i < 100; i++ {
+			if !q.TryEnqueue(i) {
+				t.Fatal("Expected unbounded queue to accept every element")
+			}
+		}
+	})
+}
+
+// TestLockFreeQueueLinearizability runs producers and consumers concurrently and checks the
+// properties a linearizable FIFO queue guarantees: every element is dequeued exactly once,
+// and each consumer sees the elements of any one producer in the order they were enqueued.
+func TestLockFreeQueueLinearizability(t *testing.T) {
+	const producers = 8
+	const consumers = 8
+	const perProducer = 5000
+
+	for _, capacity := range []int{0, 16} {
+		t.Run(fmt.Sprintf("capacity=%d", capacity), func(t *testing.T) {
+			type item struct{ producer, seq int }
+			q := NewLockFree[item](capacity)
+			var remaining atomic.Int64
+			remaining.Store(producers * perProducer)
+
+			var wg sync.WaitGroup
+			for p := 0; p < producers; p++ {
+				wg.Add(1)
+				go func(p int) {
+					defer wg.Done()
+					for i := 0; i < perProducer; {
+						if q.TryEnqueue(item{producer: p, seq: i}) {
+							i++
+						} else {
+							runtime.Gosched()
+						}
+						if capacity > 0 && q.Size() > capacity {
+							t.Errorf("Expected size at most %d, got %d", capacity, q.Size())
+						}
+					}
+				}(p)
+			}
+
+			received := make([][]item, consumers)
+			for c := 0; c < consumers; c++ {
+				wg.Add(1)
+				go func(c int) {
+					defer wg.Done()
+					for remaining.Load() > 0 {
+						if v, ok := q.TryDequeue(); ok {
+							received[c] = append(received[c], v)
+							remaining.Add(-1)
+						} else {
+							runtime.Gosched()
+						}
+					}
+				}(c)
+			}
+			wg.Wait()
+
+			seen := make([][]bool, producers)
+			for p := range seen {
+				seen[p] = make([]bool, perProducer)
+			}
+			for c, items := range received {
+				last := make([]int, producers)
+				for p := range last {
+					last[p] = -1
+				}
+				for _, v := range items {
+					if seen[v.producer][v.seq] {
+						t.Fatalf("Element %v dequeued twice", v)
+					}
+					seen[v.producer][v.seq] = true
+					if v.seq <= last[v.producer] {
+						t.Fatalf("Consumer %d saw producer %d's element %d after %d", c, v.producer, v.seq, last[v.producer])
+					}
+					last[v.producer] = v.seq
+				}
+			}
+			for p := range seen {
+				for i, ok := range seen[p] {
+					if !ok {
+						t.Fatalf("Element %d of producer %d was lost", i, p)
+					}
+				}
+			}
+			if !q.IsEmpty() || q.Size() != 0 {
+				t.Errorf("Expected queue to be empty, got size %d", q.Size())
+			}
+		})
+	}
+}
+
+// Benchmark tests
+func BenchmarkLockFreeQueueEnqueueDequeue(b *testing.B) {
+	q := NewLockFree[int](0)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		q.TryEnqueue(i)
+		q.TryDequeue()
+	}
+}
+
+// BenchmarkQueueContention runs b.N enqueue/dequeue pairs split across 1 to 64 goroutines
+// against LockFreeQueue and the mutex-based SyncQueue.
+func BenchmarkQueueContention(b *testing.B) {
+	implementations := []struct {
+		name string
+		new  func() (enqueue func(int), dequeue func() (int, bool))
+	}{
+		{"LockFree", func() (func(int), func() (int, bool)) {
+			q := NewLockFree[int](0)
+			return func(v int) { q.TryEnqueue(v) }, q.TryDequeue
+		}},
+		{"Sync", func() (func(int), func() (int, bool)) {
+			q := NewSync[int](nil)
+			return q.Enqueue, q.TryDequeue
+		}},
+	}
+
+	for _, goroutines := range []int{1, 2, 4, 8, 16, 32, 64} {
+		for _, impl := range implementations {
+			b.Run(fmt.Sprintf("%s/goroutines=%d", impl.name, goroutines), func(b *testing.B) {
+				enqueue, dequeue := impl.new()
+				var wg sync.WaitGroup
+				b.ResetTimer()
+				for g := 0; g < goroutines; g++ {
+					ops := b.N / goroutines
+					if g < b.N%goroutines {
+						ops++
+					}
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						for i := 0; i < ops; i++ {
+							enqueue(i)
+							dequeue()
+						}
+					}()
+				}
+				wg.Wait()
+			})
+		}
+	}
+}
@@ -2,7 +2,13 @@
 // using a linked list for efficient enqueue and dequeue operations.
 package queue
 
-import "github.com/thefrost13/gollections/node"
+import (
+	"fmt"
+	"iter"
+
+	"github.com/thefrost13/gollections/errs"
+	"github.com/thefrost13/gollections/node"
+)
 
 // Queue is a generic FIFO (First In, First Out) data structure implemented using a linked list.
 // It provides O(1) enqueue and dequeue operations and maintains elements in insertion order.
@@ -45,6 +51,27 @@ func New[T any](sli []T) *Queue[T] {
 	return q
 }
 
+// FromSeq creates and returns a new Queue containing the elements yielded by seq.
+// Elements are enqueued in the order they are yielded.
+// Time complexity: O(n) where n is the number of elements yielded.
+//
+// Parameters:
+//   - seq: the sequence of elements to initialize the queue with
+//
+// Returns:
+//   - a new Queue containing the elements from the sequence
+//
+// Example:
+//
+//	queue := FromSeq(slices.Values([]int{1, 2, 3}))  // Creates queue with 1 at front
+func FromSeq[T any](seq iter.Seq[T]) *Queue[T] {
+	q := &Queue[T]{}
+	for v := range seq {
+		q.Enqueue(v)
+	}
+	return q
+}
+
 // Enqueue adds an element to the back of the queue.
 // The element will be the last to be dequeued (FIFO order).
 // Time complexity: O(1).
@@ -118,6 +145,85 @@ func (q *Queue[T]) Peek() T {
 	return value
 }
 
+// TryDequeue removes and returns the front element from the queue.
+// Unlike Dequeue, it reports whether an element was actually removed, which distinguishes
+// an empty queue from a stored zero value.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the front element of the queue, or zero value if queue is empty
+//   - ok: true if an element was removed, false if the queue was empty
+//
+// Example:
+//
+//	if value, ok := queue.TryDequeue(); ok {
+//	    fmt.Printf("Dequeued: %v\n", value)
+//	}
+func (q *Queue[T]) TryDequeue() (T, bool) {
+	if q.first == nil {
+		var zero T
+		return zero, false
+	}
+	return q.Dequeue(), true
+}
+
+// TryPeek returns the front element of the queue without removing it.
+// Unlike Peek, it reports whether the queue had an element.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the front element of the queue, or zero value if queue is empty
+//   - ok: true if the queue is not empty, false otherwise
+//
+// Example:
+//
+//	if front, ok := queue.TryPeek(); ok {
+//	    fmt.Printf("Front element: %v\n", front)
+//	}
+func (q *Queue[T]) TryPeek() (T, bool) {
+	if q.first == nil {
+		var zero T
+		return zero, false
+	}
+	return q.first.Value, true
+}
+
+// MustDequeue removes and returns the front element from the queue.
+// It panics with an error wrapping errs.ErrEmpty if the queue is empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the front element of the queue
+//
+// Example:
+//
+//	value := queue.MustDequeue()  // Panics if queue is empty
+func (q *Queue[T]) MustDequeue() T {
+	value, ok := q.TryDequeue()
+	if !ok {
+		panic(fmt.Errorf("queue: Dequeue: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// MustPeek returns the front element of the queue without removing it.
+// It panics with an error wrapping errs.ErrEmpty if the queue is empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the front element of the queue
+//
+// Example:
+//
+//	front := queue.MustPeek()  // Panics if queue is empty
+func (q *Queue[T]) MustPeek() T {
+	value, ok := q.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("queue: Peek: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
 // Size returns the number of elements in the queue.
 // Time complexity: O(1).
 //
@@ -169,6 +275,57 @@ func (q *Queue[T]) ToSlice() []T {
 	return slice
 }
 
+// All returns an iterator over the elements of the queue in FIFO order.
+// The first element yielded is the front of the queue.
+// The queue must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, with no allocation of an intermediate slice.
+//
+// Returns:
+//   - an iter.Seq yielding the queue elements from front to back
+//
+// Example:
+//
+//	for v := range queue.All() {
+//	    fmt.Println(v)
+//	}
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := q.first; current != nil; current = current.Next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the queue from back to front.
+// The first element yielded is the most recently enqueued element.
+// Because the queue is singly linked, the nodes are buffered before iteration begins.
+// The queue must not be modified during iteration.
+// Time complexity: O(n) time and O(n) space.
+//
+// Returns:
+//   - an iter.Seq yielding the queue elements from back to front
+//
+// Example:
+//
+//	for v := range queue.Backward() {
+//	    fmt.Println(v)  // Newest element first
+//	}
+func (q *Queue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		nodes := make([]*node.LinkedNode[T], 0, q.size)
+		for current := q.first; current != nil; current = current.Next {
+			nodes = append(nodes, current)
+		}
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !yield(nodes[i].Value) {
+				return
+			}
+		}
+	}
+}
+
 // Clear removes all elements from the queue, making it empty.
 // Time complexity: O(1).
 //
@@ -1,8 +1,12 @@
 package queue
 
 import (
+	"errors"
 	"reflect"
+	"slices"
 	"testing"
+
+	"github.com/thefrost13/gollections/errs"
 )
 
 func TestNew(t *testing.T) {
@@ -455,6 +459,136 @@ func TestQueueBehavior(t *testing.T) {
 	})
 }
 
+func TestQueueFromSeq(t *testing.T) {
+	q := FromSeq(slices.Values([]int{1, 2, 3}))
+	if q.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", q.Size())
+	}
+	if q.Peek() != 1 {
+		t.Errorf("Expected front 1, got %d", q.Peek())
+	}
+	if !reflect.DeepEqual(q.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", q.ToSlice())
+	}
+
+	empty := FromSeq(slices.Values([]int(nil)))
+	if !empty.IsEmpty() {
+		t.Error("Expected empty queue from empty sequence")
+	}
+}
+
+func TestQueueIterators(t *testing.T) {
+	t.Run("all yields FIFO order", func(t *testing.T) {
+		q := New([]int{1, 2, 3})
+		got := slices.Collect(q.All())
+		if !reflect.DeepEqual(got, q.ToSlice()) {
+			t.Errorf("Expected %v, got %v", q.ToSlice(), got)
+		}
+	})
+
+	t.Run("backward yields reverse order", func(t *testing.T) {
+		q := New([]int{1, 2, 3})
+		got := slices.Collect(q.Backward())
+		expected := []int{3, 2, 1}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("empty queue", func(t *testing.T) {
+		q := New[int](nil)
+		if got := slices.Collect(q.All()); len(got) != 0 {
+			t.Errorf("Expected no elements, got %v", got)
+		}
+		if got := slices.Collect(q.Backward()); len(got) != 0 {
+			t.Errorf("Expected no elements, got %v", got)
+		}
+	})
+
+	t.Run("early break", func(t *testing.T) {
+		q := New([]int{1, 2, 3})
+		var got []int
+		for v := range q.All() {
+			got = append(got, v)
+			break
+		}
+		for v := range q.Backward() {
+			got = append(got, v)
+			break
+		}
+		if !reflect.DeepEqual(got, []int{1, 3}) {
+			t.Errorf("Expected [1 3], got %v", got)
+		}
+	})
+}
+
+// recoverError runs fn and returns the error it panicked with, or nil if it did not panic.
+func recoverError(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, _ = r.(error)
+		}
+	}()
+	fn()
+	return nil
+}
+
+func TestQueueTryOperations(t *testing.T) {
+	t.Run("try on empty queue", func(t *testing.T) {
+		q := New[int](nil)
+		if _, ok := q.TryDequeue(); ok {
+			t.Error("Expected TryDequeue to report empty queue")
+		}
+		if _, ok := q.TryPeek(); ok {
+			t.Error("Expected TryPeek to report empty queue")
+		}
+	})
+
+	t.Run("try distinguishes stored zero value", func(t *testing.T) {
+		q := New([]string{""})
+		if value, ok := q.TryPeek(); !ok || value != "" {
+			t.Errorf("Expected (\"\", true), got (%q, %t)", value, ok)
+		}
+		if value, ok := q.TryDequeue(); !ok || value != "" {
+			t.Errorf("Expected (\"\", true), got (%q, %t)", value, ok)
+		}
+		if !q.IsEmpty() || q.last != nil {
+			t.Error("Expected queue to be empty after TryDequeue")
+		}
+	})
+
+	t.Run("try dequeue follows FIFO order", func(t *testing.T) {
+		q := New([]string{"a", "b"})
+		first, _ := q.TryDequeue()
+		second, _ := q.TryDequeue()
+		if first != "a" || second != "b" {
+			t.Errorf("Expected a, b; got %s, %s", first, second)
+		}
+	})
+}
+
+func TestQueueMustOperations(t *testing.T) {
+	t.Run("must on empty queue panics with ErrEmpty", func(t *testing.T) {
+		q := New[int](nil)
+		if err := recoverError(func() { q.MustDequeue() }); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected MustDequeue to panic with ErrEmpty, got %v", err)
+		}
+		if err := recoverError(func() { q.MustPeek() }); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected MustPeek to panic with ErrEmpty, got %v", err)
+		}
+	})
+
+	t.Run("must on non-empty queue", func(t *testing.T) {
+		q := New([]int{1, 2})
+		if q.MustPeek() != 1 {
+			t.Error("Expected MustPeek to return 1")
+		}
+		if q.MustDequeue() != 1 || q.MustDequeue() != 2 {
+			t.Error("Expected MustDequeue to return 1 then 2")
+		}
+	})
+}
+
 // Benchmark tests
 func BenchmarkQueueEnqueue(b *testing.B) {
 	q := New[int](nil)
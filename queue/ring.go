@@ -0,0 +1,259 @@
+package queue
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/thefrost13/gollections/errs"
+	"github.com/thefrost13/gollections/internal/ring"
+)
+
+// RingQueue is a generic FIFO queue backed by a slice used as a circular buffer.
+// It has the same method set as Queue but stores elements contiguously, so it avoids a
+// per-element allocation and has better cache locality. The buffer doubles when full and
+// halves when it falls to a quarter full, keeping memory proportional to the number of elements.
+// The zero value is ready to use but NewRing should be preferred for initialization.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type RingQueue[T any] struct {
+	buf  []T // circular buffer; its length is zero or a power of two
+	head int // index of the front element in buf
+	size int // number of elements in the queue
+}
+
+// NewRing creates and returns a new RingQueue initialized with the elements from the given slice.
+// Elements are enqueued in the order they appear in the slice.
+// If the slice is nil, an empty queue is returned.
+// Time complexity: O(n) where n is the length of the slice.
+//
+// Parameters:
+//   - sli: slice of elements to initialize the queue with, can be nil
+//
+// Returns:
+//   - a new RingQueue containing the elements from the slice
+//
+// Example:
+//
+//	queue := NewRing([]int{1, 2, 3})  // Creates queue with 1 at front
+//	emptyQueue := NewRing[string](nil)  // Creates empty queue
+func NewRing[T any](sli []T) *RingQueue[T] {
+	q := &RingQueue[T]{}
+	if len(sli) > 0 {
+		q.resize(ring.CapacityFor(len(sli)))
+		copy(q.buf, sli)
+		q.size = len(sli)
+	}
+	return q
+}
+
+// Enqueue adds an element to the back of the queue, growing the buffer if it is full.
+// Time complexity: O(1) amortized.
+//
+// Parameters:
+//   - value: the element to add to the queue
+//
+// Example:
+//
+//	queue.Enqueue(42)  // Add 42 to back of queue
+func (q *RingQueue[T]) Enqueue(value T) {
+	if q.size == len(q.buf) {
+		q.resize(max(2*len(q.buf), ring.MinCapacity))
+	}
+	q.buf[(q.head+q.size)&(len(q.buf)-1)] = value
+	q.size++
+}
+
+// Dequeue removes and returns the front element from the queue, shrinking the buffer
+// once it is only a quarter full.
+// If the queue is empty, returns the zero value of type T.
+// Time complexity: O(1) amortized.
+//
+// Returns:
+//   - the front element of the queue, or zero value if queue is empty
+//
+// Example:
+//
+//	value := queue.Dequeue()  // Remove and return front element
+func (q *RingQueue[T]) Dequeue() T {
+	value, _ := q.TryDequeue()
+	return value
+}
+
+// Peek returns the front element of the queue without removing it.
+// If the queue is empty, returns the zero value of type T.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the front element of the queue, or zero value if queue is empty
+//
+// Example:
+//
+//	front := queue.Peek()  // Look at front element without removing it
+func (q *RingQueue[T]) Peek() T {
+	value, _ := q.TryPeek()
+	return value
+}
+
+// TryDequeue removes and returns the front element from the queue.
+// Unlike Dequeue, it reports whether an element was actually removed.
+// Time complexity: O(1) amortized.
+//
+// Returns:
+//   - value: the front element of the queue, or zero value if queue is empty
+//   - ok: true if an element was removed, false if the queue was empty
+//
+// Example:
+//
+//	if value, ok := queue.TryDequeue(); ok {
+//	    fmt.Printf("Dequeued: %v\n", value)
+//	}
+func (q *RingQueue[T]) TryDequeue() (T, bool) {
+	var zero T
+	if q.size == 0 {
+		return zero, false
+	}
+	value := q.buf[q.head]
+	q.buf[q.head] = zero
+	q.head = (q.head + 1) & (len(q.buf) - 1)
+	q.size--
+	if len(q.buf) > ring.MinCapacity && q.size <= len(q.buf)/4 {
+		q.resize(len(q.buf) / 2)
+	}
+	return value, true
+}
+
+// TryPeek returns the front element of the queue without removing it.
+// Unlike Peek, it reports whether the queue had an element.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the front element of the queue, or zero value if queue is empty
+//   - ok: true if the queue is not empty, false otherwise
+//
+// Example:
+//
+//	if front, ok := queue.TryPeek(); ok {
+//	    fmt.Printf("Front element: %v\n", front)
+//	}
+func (q *RingQueue[T]) TryPeek() (T, bool) {
+	if q.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.buf[q.head], true
+}
+
+// MustDequeue removes and returns the front element from the queue.
+// It panics with an error wrapping errs.ErrEmpty if the queue is empty.
+// Time complexity: O(1) amortized.
+//
+// Returns:
+//   - the front element of the queue
+//
+// Example:
+//
+//	value := queue.MustDequeue()  // Panics if queue is empty
+func (q *RingQueue[T]) MustDequeue() T {
+	value, ok := q.TryDequeue()
+	if !ok {
+		panic(fmt.Errorf("queue: Dequeue: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// MustPeek returns the front element of the queue without removing it.
+// It panics with an error wrapping errs.ErrEmpty if the queue is empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the front element of the queue
+//
+// Example:
+//
+//	front := queue.MustPeek()  // Panics if queue is empty
+func (q *RingQueue[T]) MustPeek() T {
+	value, ok := q.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("queue: Peek: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// Size returns the number of elements in the queue.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the number of elements in the queue
+func (q *RingQueue[T]) Size() int {
+	return q.size
+}
+
+// IsEmpty returns true if the queue contains no elements.
+// Time complexity: O(1).
+//
+// Returns:
+//   - true if the queue is empty, false otherwise
+func (q *RingQueue[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+// ToSlice returns a slice containing all elements in the queue in FIFO order.
+// The first element in the slice is the front of the queue.
+// The returned slice is a copy and modifications to it will not affect the queue.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - a slice containing all queue elements in FIFO order
+func (q *RingQueue[T]) ToSlice() []T {
+	slice := make([]T, q.size)
+	ring.CopyTo(slice, q.buf, q.head, q.size)
+	return slice
+}
+
+// All returns an iterator over the elements of the queue in FIFO order.
+// The queue must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, with no allocation of an intermediate slice.
+//
+// Returns:
+//   - an iter.Seq yielding the queue elements from front to back
+func (q *RingQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(q.buf[(q.head+i)&(len(q.buf)-1)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the queue from back to front.
+// The queue must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, with no allocation of an intermediate slice.
+//
+// Returns:
+//   - an iter.Seq yielding the queue elements from back to front
+func (q *RingQueue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := q.size - 1; i >= 0; i-- {
+			if !yield(q.buf[(q.head+i)&(len(q.buf)-1)]) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all elements from the queue, making it empty and releasing its buffer.
+// Time complexity: O(1).
+func (q *RingQueue[T]) Clear() {
+	q.buf = nil
+	q.head = 0
+	q.size = 0
+}
+
+// resize moves the elements into a new buffer of the given power-of-two capacity,
+// placing the front element at index 0.
+func (q *RingQueue[T]) resize(capacity int) {
+	q.buf = ring.Resize(q.buf, q.head, q.size, capacity)
+	q.head = 0
+}
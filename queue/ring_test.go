@@ -0,0 +1,393 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/thefrost13/gollections/errs"
+	"github.com/thefrost13/gollections/internal/ring"
+)
+
+func TestNewRing(t *testing.T) {
+	t.Run("NewRing with nil slice", func(t *testing.T) {
+		q := NewRing[int](nil)
+		if q == nil {
+			t.Fatal("Expected queue to be initialized, got nil")
+		}
+		if q.Size() != 0 {
+			t.Errorf("Expected size 0, got %d", q.Size())
+		}
+		if q.buf != nil {
+			t.Error("Expected buffer to be nil")
+		}
+	})
+
+	t.Run("NewRing with multiple elements", func(t *testing.T) {
+		values := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		q := NewRing(values)
+		if q.Size() != len(values) {
+			t.Errorf("Expected size %d, got %d", len(values), q.Size())
+		}
+		if len(q.buf) != 16 {
+			t.Errorf("Expected capacity 16, got %d", len(q.buf))
+		}
+		for i, expected := range values {
+			if actual := q.Dequeue(); actual != expected {
+				t.Errorf("At position %d, expected %d, got %d", i, expected, actual)
+			}
+		}
+	})
+
+	t.Run("NewRing does not share the slice", func(t *testing.T) {
+		values := []int{1, 2, 3}
+		q := NewRing(values)
+		values[0] = 100
+		if q.Peek() != 1 {
+			t.Errorf("Expected peek value 1, got %d", q.Peek())
+		}
+	})
+}
+
+func TestRingQueueOperations(t *testing.T) {
+	t.Run("Enqueue and Dequeue in FIFO order", func(t *testing.T) {
+		q := NewRing[string](nil)
+		q.Enqueue("a")
+		q.Enqueue("b")
+		q.Enqueue("c")
+		if q.Peek() != "a" {
+			t.Errorf("Expected peek value a, got %s", q.Peek())
+		}
+		for _, expected := range []string{"a", "b", "c"} {
+			if actual := q.Dequeue(); actual != expected {
+				t.Errorf("Expected %s, got %s", expected, actual)
+			}
+		}
+		if !q.IsEmpty() {
+			t.Error("Expected queue to be empty")
+		}
+	})
+
+	t.Run("Dequeue and Peek on empty queue return zero value", func(t *testing.T) {
+		q := NewRing[int](nil)
+		if v := q.Dequeue(); v != 0 {
+			t.Errorf("Expected 0, got %d", v)
+		}
+		if v := q.Peek(); v != 0 {
+			t.Errorf("Expected 0, got %d", v)
+		}
+	})
+
+	t.Run("Zero value queue is usable", func(t *testing.T) {
+		var q RingQueue[int]
+		q.Enqueue(1)
+		if v := q.Dequeue(); v != 1 {
+			t.Errorf("Expected 1, got %d", v)
+		}
+	})
+
+	t.Run("Wrap around keeps FIFO order", func(t *testing.T) {
+		q := NewRing[int](nil)
+		for i := 0; i < 6; i++ {
+			q.Enqueue(i)
+		}
+		for i := 0; i < 4; i++ {
+			q.Dequeue()
+		}
+		for i := 6; i < 12; i++ {
+			q.Enqueue(i)
+		}
+		if len(q.buf) != ring.MinCapacity {
+			t.Errorf("Expected capacity %d, got %d", ring.MinCapacity, len(q.buf))
+		}
+		expected := []int{4, 5, 6, 7, 8, 9, 10, 11}
+		if !reflect.DeepEqual(q.ToSlice(), expected) {
+			t.Errorf("Expected %v, got %v", expected, q.ToSlice())
+		}
+		// Growing while wrapped must preserve order
+		q.Enqueue(12)
+		expected = append(expected, 12)
+		if !reflect.DeepEqual(q.ToSlice(), expected) {
+			t.Errorf("Expected %v, got %v", expected, q.ToSlice())
+		}
+	})
+
+	t.Run("Dequeue clears the vacated slot", func(t *testing.T) {
+		q := NewRing([]*int{new(int), new(int)})
+		head := q.head
+		q.Dequeue()
+		if q.buf[head] != nil {
+			t.Error("Expected dequeued slot to be cleared")
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		q := NewRing([]int{1, 2, 3})
+		q.Clear()
+		if !q.IsEmpty() {
+			t.Error("Expected queue to be empty after Clear")
+		}
+		if q.buf != nil {
+			t.Error("Expected buffer to be released after Clear")
+		}
+		q.Enqueue(4)
+		if q.Peek() != 4 {
+			t.Errorf("Expected peek value 4, got %d", q.Peek())
+		}
+	})
+}
+
+func TestRingQueueResize(t *testing.T) {
+	t.Run("Grows by doubling", func(t *testing.T) {
+		q := NewRing[int](nil)
+		for i := 0; i < 100; i++ {
+			q.Enqueue(i)
+		}
+		if len(q.buf) != 128 {
+			t.Errorf("Expected capacity 128, got %d", len(q.buf))
+		}
+	})
+
+	t.Run("Shrinks when a quarter full", func(t *testing.T) {
+		q := NewRing[int](nil)
+		for i := 0; i < 1024; i++ {
+			q.Enqueue(i)
+		}
+		for i := 0; i < 1024-256; i++ {
+			if v := q.Dequeue(); v != i {
+				t.Fatalf("Expected %d, got %d", i, v)
+			}
+		}
+		if len(q.buf) != 512 {
+			t.Errorf("Expected capacity 512, got %d", len(q.buf))
+		}
+		for i := 1024 - 256; i < 1024; i++ {
+			if v := q.Dequeue(); v != i {
+				t.Fatalf("Expected %d, got %d", i, v)
+			}
+		}
+		if len(q.buf) != ring.MinCapacity {
+			t.Errorf("Expected capacity %d, got %d", ring.MinCapacity, len(q.buf))
+		}
+	})
+
+	t.Run("Matches linked-list Queue under mixed operations", func(t *testing.T) {
+		ring := NewRing[int](nil)
+		linked := New[int](nil)
+		for i := 0; i < 10000; i++ {
+			if i%3 == 2 {
+				if r, l := ring.Dequeue(), linked.Dequeue(); r != l {
+					t.Fatalf("At step %d, expected %d, got %d", i, l, r)
+				}
+			} else {
+				ring.Enqueue(i)
+				linked.Enqueue(i)
+			}
+			if i%1000 == 0 && !reflect.DeepEqual(ring.ToSlice(), linked.ToSlice()) {
+				t.Fatalf("At step %d, contents differ", i)
+			}
+		}
+		if ring.Size() != linked.Size() {
+			t.Errorf("Expected size %d, got %d", linked.Size(), ring.Size())
+		}
+	})
+}
+
+func TestRingQueueIterators(t *testing.T) {
+	q := NewRing[int](nil)
+	for i := 0; i < 10; i++ {
+		q.Enqueue(i)
+	}
+	for i := 0; i < 5; i++ {
+		q.Dequeue()
+	}
+	for i := 10; i < 13; i++ {
+		q.Enqueue(i)
+	}
+
+	t.Run("All", func(t *testing.T) {
+		got := slices.Collect(q.All())
+		expected := []int{5, 6, 7, 8, 9, 10, 11, 12}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("Backward", func(t *testing.T) {
+		got := slices.Collect(q.Backward())
+		expected := []int{12, 11, 10, 9, 8, 7, 6, 5}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("Early break", func(t *testing.T) {
+		var got []int
+		for v := range q.All() {
+			if v == 7 {
+				break
+			}
+			got = append(got, v)
+		}
+		if !reflect.DeepEqual(got, []int{5, 6}) {
+			t.Errorf("Expected [5 6], got %v", got)
+		}
+	})
+
+	t.Run("Empty queue", func(t *testing.T) {
+		empty := NewRing[int](nil)
+		for range empty.All() {
+			t.Error("Expected no elements")
+		}
+		for range empty.Backward() {
+			t.Error("Expected no elements")
+		}
+	})
+}
+
+func TestRingQueueTryAndMustOperations(t *testing.T) {
+	t.Run("Try on empty queue", func(t *testing.T) {
+		q := NewRing[int](nil)
+		if _, ok := q.TryDequeue(); ok {
+			t.Error("Expected TryDequeue to report false on empty queue")
+		}
+		if _, ok := q.TryPeek(); ok {
+			t.Error("Expected TryPeek to report false on empty queue")
+		}
+	})
+
+	t.Run("Try distinguishes stored zero value", func(t *testing.T) {
+		q := NewRing([]int{0})
+		if v, ok := q.TryPeek(); !ok || v != 0 {
+			t.Errorf("Expected (0, true), got (%d, %v)", v, ok)
+		}
+		if v, ok := q.TryDequeue(); !ok || v != 0 {
+			t.Errorf("Expected (0, true), got (%d, %v)", v, ok)
+		}
+	})
+
+	t.Run("Must on empty queue panics with ErrEmpty", func(t *testing.T) {
+		q := NewRing[int](nil)
+		if err := recoverError(func() { q.MustDequeue() }); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected ErrEmpty panic, got %v", err)
+		}
+		if err := recoverError(func() { q.MustPeek() }); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected ErrEmpty panic, got %v", err)
+		}
+	})
+
+	t.Run("Must on non-empty queue", func(t *testing.T) {
+		q := NewRing([]int{7, 8})
+		if v := q.MustPeek(); v != 7 {
+			t.Errorf("Expected 7, got %d", v)
+		}
+		if v := q.MustDequeue(); v != 7 {
+			t.Errorf("Expected 7, got %d", v)
+		}
+	})
+}
+
+// Benchmark tests
+func BenchmarkRingQueueEnqueue(b *testing.B) {
+	q := NewRing[int](nil)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		q.Enqueue(i)
+	}
+}
+
+func BenchmarkRingQueueDequeue(b *testing.B) {
+	q := NewRing[int](nil)
+	for i := 0; i < b.N; i++ {
+		q.Enqueue(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.Dequeue()
+	}
+}
+
+func BenchmarkRingQueueMixedOperations(b *testing.B) {
+	q := NewRing[int](nil)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		q.Enqueue(i)
+		if i%2 == 0 && q.Size() > 0 {
+			q.Dequeue()
+		}
+		q.Peek()
+	}
+}
+
+// fifoQueue is the method subset shared by Queue and RingQueue that the comparative benchmarks exercise.
+type fifoQueue interface {
+	Enqueue(int)
+	Dequeue() int
+}
+
+// benchmarkSizes are the element counts used to compare the linked-list and ring-buffer queues.
+var benchmarkSizes = []int{1_000, 10_000, 100_000, 1_000_000, 10_000_000}
+
+// BenchmarkQueueImplementations fills each queue with n elements and drains it again,
+// reporting the per-element cost and allocations of both implementations.
+func BenchmarkQueueImplementations(b *testing.B) {
+	impls := []struct {
+		name string
+		new  func() fifoQueue
+	}{
+		{"Linked", func() fifoQueue { return New[int](nil) }},
+		{"Ring", func() fifoQueue { return NewRing[int](nil) }},
+	}
+
+	for _, n := range benchmarkSizes {
+		for _, impl := range impls {
+			b.Run(fmt.Sprintf("%s/n=%d", impl.name, n), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					q := impl.new()
+					for j := 0; j < n; j++ {
+						q.Enqueue(j)
+					}
+					for j := 0; j < n; j++ {
+						q.Dequeue()
+					}
+				}
+				b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/elem")
+			})
+		}
+	}
+}
+
+// BenchmarkQueueSteadyState keeps n elements queued and measures one Enqueue/Dequeue pair,
+// which is the common pattern for long-lived buffers.
+func BenchmarkQueueSteadyState(b *testing.B) {
+	for _, n := range benchmarkSizes {
+		b.Run(fmt.Sprintf("Linked/n=%d", n), func(b *testing.B) {
+			q := New[int](nil)
+			for j := 0; j < n; j++ {
+				q.Enqueue(j)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				q.Enqueue(q.Dequeue())
+			}
+		})
+		b.Run(fmt.Sprintf("Ring/n=%d", n), func(b *testing.B) {
+			q := NewRing[int](nil)
+			for j := 0; j < n; j++ {
+				q.Enqueue(j)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				q.Enqueue(q.Dequeue())
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package queue
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncQueue is a Queue that is safe for concurrent use by multiple goroutines.
+// Reads take a shared lock and writes take an exclusive lock on a sync.RWMutex,
+// and compound operations such as TryDequeue and DequeueIf are performed atomically.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type SyncQueue[T any] struct {
+	mu    sync.RWMutex // guards queue
+	queue *Queue[T]    // the wrapped queue
+}
+
+// NewSync creates and returns a new SyncQueue wrapping the given queue.
+// The caller must not use q directly afterwards. If q is nil, an empty queue is used.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - q: the Queue to guard, can be nil
+//
+// Returns:
+//   - a new SyncQueue guarding q
+//
+// Example:
+//
+//	queue := NewSync(New([]int{1, 2, 3}))
+//	go queue.Enqueue(4)
+func NewSync[T any](q *Queue[T]) *SyncQueue[T] {
+	if q == nil {
+		q = &Queue[T]{}
+	}
+	return &SyncQueue[T]{queue: q}
+}
+
+// Enqueue adds an element to the back of the queue.
+// Time complexity: O(1).
+func (q *SyncQueue[T]) Enqueue(value T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queue.Enqueue(value)
+}
+
+// Dequeue removes and returns the front element from the queue.
+// If the queue is empty, returns the zero value of type T.
+// Time complexity: O(1).
+func (q *SyncQueue[T]) Dequeue() T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.Dequeue()
+}
+
+// Peek returns the front element of the queue without removing it.
+// If the queue is empty, returns the zero value of type T.
+// Time complexity: O(1).
+func (q *SyncQueue[T]) Peek() T {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.Peek()
+}
+
+// TryDequeue atomically removes and returns the front element if the queue is not empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the front element of the queue, or zero value if queue is empty
+//   - ok: true if an element was removed, false if the queue was empty
+func (q *SyncQueue[T]) TryDequeue() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.TryDequeue()
+}
+
+// TryPeek returns the front element of the queue without removing it.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the front element of the queue, or zero value if queue is empty
+//   - ok: true if the queue is not empty, false otherwise
+func (q *SyncQueue[T]) TryPeek() (T, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.TryPeek()
+}
+
+// MustDequeue removes and returns the front element from the queue.
+// It panics with an error wrapping errs.ErrEmpty if the queue is empty.
+// Time complexity: O(1).
+func (q *SyncQueue[T]) MustDequeue() T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.MustDequeue()
+}
+
+// MustPeek returns the front element of the queue without removing it.
+// It panics with an error wrapping errs.ErrEmpty if the queue is empty.
+// Time complexity: O(1).
+func (q *SyncQueue[T]) MustPeek() T {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.MustPeek()
+}
+
+// DequeueIf atomically removes and returns the front element if the queue is not empty
+// and the element satisfies predicate. The predicate runs while the lock is held
+// and must not call methods on the same queue.
+// Time complexity: O(1) plus the cost of predicate.
+//
+// Parameters:
+//   - predicate: reports whether the front element should be dequeued
+//
+// Returns:
+//   - value: the dequeued element, or zero value if nothing was dequeued
+//   - ok: true if an element was dequeued, false otherwise
+//
+// Example:
+//
+//	// Dequeue only messages that are ready
+//	msg, ok := queue.DequeueIf(func(m Message) bool { return m.Ready })
+func (q *SyncQueue[T]) DequeueIf(predicate func(T) bool) (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if front, ok := q.queue.TryPeek(); !ok || !predicate(front) {
+		var zero T
+		return zero, false
+	}
+	return q.queue.TryDequeue()
+}
+
+// DequeueAll atomically removes every element and returns them in FIFO order.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - a slice containing the removed elements, front first
+func (q *SyncQueue[T]) DequeueAll() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	values := q.queue.ToSlice()
+	q.queue.Clear()
+	return values
+}
+
+// Size returns the number of elements in the queue.
+// Time complexity: O(1).
+func (q *SyncQueue[T]) Size() int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.Size()
+}
+
+// IsEmpty returns true if the queue contains no elements.
+// Time complexity: O(1).
+func (q *SyncQueue[T]) IsEmpty() bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.IsEmpty()
+}
+
+// ToSlice returns a slice containing all elements in the queue in FIFO order.
+// Time complexity: O(n) where n is the number of elements.
+func (q *SyncQueue[T]) ToSlice() []T {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.ToSlice()
+}
+
+// All returns an iterator over a snapshot of the queue in FIFO order.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the queue.
+// Time complexity: O(n) time and O(n) space.
+func (q *SyncQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range q.ToSlice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over a snapshot of the queue from back to front.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the queue.
+// Time complexity: O(n) time and O(n) space.
+func (q *SyncQueue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		values := q.ToSlice()
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all elements from the queue, making it empty.
+// Time complexity: O(1).
+func (q *SyncQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queue.Clear()
+}
+
+// MarshalJSON implements json.Marshaler using the encoding of the wrapped queue.
+// A zero-value wrapper encodes as an empty queue.
+// Time complexity: O(n).
+func (q *SyncQueue[T]) MarshalJSON() ([]byte, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.queue == nil {
+		return New[T](nil).MarshalJSON()
+	}
+	return q.queue.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of the wrapped queue.
+// A zero-value wrapper gets a new empty queue to decode into.
+// Time complexity: O(n).
+func (q *SyncQueue[T]) UnmarshalJSON(data []byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.queue == nil {
+		q.queue = New[T](nil)
+	}
+	return q.queue.UnmarshalJSON(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using the encoding of the wrapped queue.
+// A zero-value wrapper encodes as an empty queue.
+// Time complexity: O(n).
+func (q *SyncQueue[T]) MarshalBinary() ([]byte, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.queue == nil {
+		return New[T](nil).MarshalBinary()
+	}
+	return q.queue.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the wrapped queue.
+// A zero-value wrapper gets a new empty queue to decode into.
+// Time complexity: O(n).
+func (q *SyncQueue[T]) UnmarshalBinary(data []byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.queue == nil {
+		q.queue = New[T](nil)
+	}
+	return q.queue.UnmarshalBinary(data)
+}
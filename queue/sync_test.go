@@ -0,0 +1,152 @@
+package queue
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/thefrost13/gollections/errs"
+)
+
+func TestNewSync(t *testing.T) {
+	t.Run("wrap nil queue", func(t *testing.T) {
+		q := NewSync[int](nil)
+		if !q.IsEmpty() {
+			t.Error("Expected empty queue")
+		}
+		q.Enqueue(1)
+		if q.Peek() != 1 {
+			t.Error("Expected front 1")
+		}
+	})
+
+	t.Run("wrap existing queue", func(t *testing.T) {
+		q := NewSync(New([]int{1, 2, 3}))
+		if !reflect.DeepEqual(q.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", q.ToSlice())
+		}
+	})
+}
+
+func TestSyncQueueOperations(t *testing.T) {
+	q := NewSync(New([]int{1, 2, 3}))
+
+	if value, ok := q.TryPeek(); !ok || value != 1 {
+		t.Errorf("Expected (1, true), got (%d, %t)", value, ok)
+	}
+	if _, ok := q.DequeueIf(func(v int) bool { return v > 10 }); ok {
+		t.Error("Expected DequeueIf to reject front element")
+	}
+	if value, ok := q.DequeueIf(func(v int) bool { return v == 1 }); !ok || value != 1 {
+		t.Errorf("Expected DequeueIf to dequeue 1, got (%d, %t)", value, ok)
+	}
+	if q.MustPeek() != 2 || q.MustDequeue() != 2 {
+		t.Error("Expected Must operations to return 2")
+	}
+	if value, ok := q.TryDequeue(); !ok || value != 3 {
+		t.Errorf("Expected (3, true), got (%d, %t)", value, ok)
+	}
+	if _, ok := q.DequeueIf(func(int) bool { return true }); ok {
+		t.Error("Expected DequeueIf on empty queue to fail")
+	}
+	if err := recoverError(func() { q.MustDequeue() }); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("Expected MustDequeue to panic with ErrEmpty, got %v", err)
+	}
+
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	if got := slices.Collect(q.All()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Expected All [1 2 3], got %v", got)
+	}
+	if got := slices.Collect(q.Backward()); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("Expected Backward [3 2 1], got %v", got)
+	}
+	for range q.All() {
+		q.Dequeue() // modifying during iteration must not deadlock
+	}
+	if q.Size() != 0 {
+		t.Errorf("Expected size 0, got %d", q.Size())
+	}
+
+	q.Enqueue(4)
+	q.Enqueue(5)
+	if got := q.DequeueAll(); !reflect.DeepEqual(got, []int{4, 5}) || !q.IsEmpty() {
+		t.Errorf("Expected DequeueAll to drain [4 5], got %v", got)
+	}
+	q.Enqueue(6)
+	q.Clear()
+	if q.Dequeue() != 0 {
+		t.Error("Expected zero value after Clear")
+	}
+}
+
+func TestSyncQueueConcurrent(t *testing.T) {
+	const producers = 16
+	const consumers = 16
+	const perProducer = 1000
+
+	q := NewSync[int](nil)
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Enqueue(p*perProducer + i)
+			}
+		}(p)
+	}
+
+	results := make(chan []int, consumers)
+	var consumersWg sync.WaitGroup
+	var producersDone atomic.Bool
+	for c := 0; c < consumers; c++ {
+		consumersWg.Add(1)
+		go func() {
+			defer consumersWg.Done()
+			var got []int
+			for {
+				value, ok := q.TryDequeue()
+				if ok {
+					got = append(got, value)
+					continue
+				}
+				if producersDone.Load() && q.IsEmpty() {
+					break
+				}
+			}
+			results <- got
+		}()
+	}
+
+	wg.Wait()
+	producersDone.Store(true)
+	consumersWg.Wait()
+	close(results)
+
+	seen := make([]bool, producers*perProducer)
+	for got := range results {
+		// each consumer must observe every producer's values in FIFO order
+		last := make(map[int]int)
+		for _, v := range got {
+			if seen[v] {
+				t.Fatalf("Value %d dequeued twice", v)
+			}
+			seen[v] = true
+			p := v / perProducer
+			if prev, ok := last[p]; ok && v < prev {
+				t.Fatalf("Producer %d values out of order: %d after %d", p, v, prev)
+			}
+			last[p] = v
+		}
+	}
+	for v, ok := range seen {
+		if !ok {
+			t.Fatalf("Value %d was never dequeued", v)
+		}
+	}
+}
@@ -0,0 +1,320 @@
+// Package scheduler runs one-shot and recurring callbacks at points in time from a single
+// dispatcher goroutine. Pending tasks are kept in a priorityqueue.Queue keyed by their next run time,
+// so scheduling, cancelling and rescheduling cost O(log n) and only one runtime timer is active
+// no matter how many tasks are pending.
+package scheduler
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/thefrost13/gollections/clock"
+	"github.com/thefrost13/gollections/priorityqueue"
+)
+
+// task is a callback waiting in the scheduler's queue.
+type task struct {
+	fn       func()                                 // the callback to run
+	interval time.Duration                          // time between runs of a recurring task, 0 for a one-shot task
+	handle   priorityqueue.Handle[*task, time.Time] // position in the queue, refreshed each time the task is queued
+}
+
+// Handle identifies a task returned by Schedule, After or Every.
+// The zero Handle does not identify any task.
+type Handle struct {
+	task *task // the scheduled task, nil for the zero Handle
+}
+
+// Scheduler runs callbacks at scheduled times on a single dispatcher goroutine.
+// Callbacks run one at a time in run-time order, with equal run times in scheduling order,
+// so a slow callback delays the ones after it and long work should be handed off to another goroutine.
+// Callbacks may schedule, cancel and reschedule tasks. It is safe for concurrent use.
+// A Scheduler must be created with New or NewWithClock and released with Stop.
+type Scheduler struct {
+	mu      sync.Mutex                             // guards tasks and stopped
+	tasks   *priorityqueue.Queue[*task, time.Time] // pending tasks, earliest run time first
+	clock   clock.TimerClock                       // source of the current time and of timers
+	stopped bool                                   // whether Stop has been called
+	wake    chan struct{}                          // signals the dispatcher that the earliest run time moved earlier
+	stop    chan struct{}                          // closed by Stop to end the dispatcher
+	done    chan struct{}                          // closed when the dispatcher has exited
+}
+
+// New creates a Scheduler that uses the system clock and starts its dispatcher goroutine.
+// Time complexity: O(1).
+//
+// Returns:
+//   - a new running Scheduler with no tasks
+//
+// Example:
+//
+//	s := New()
+//	defer s.Stop()
+//	s.Every(time.Minute, flushMetrics)
+func New() *Scheduler {
+	return NewWithClock(clock.System())
+}
+
+// NewWithClock creates a Scheduler that reads time and creates timers from the given clock
+// and starts its dispatcher goroutine. If c is nil, the system clock is used.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - c: the clock that decides when tasks run, can be nil
+//
+// Returns:
+//   - a new running Scheduler with no tasks
+//
+// Example:
+//
+//	fake := clock.NewFake(time.Now())
+//	s := NewWithClock(fake)
+//	s.After(time.Minute, func() { fmt.Println("tick") })
+//	fake.BlockUntil(1)
+//	fake.Advance(time.Minute)  // prints "tick"
+func NewWithClock(c clock.TimerClock) *Scheduler {
+	if c == nil {
+		c = clock.System()
+	}
+	s := &Scheduler{
+		tasks: priorityqueue.NewLess[*task](time.Time.Before, priorityqueue.WithStable()),
+		clock: c,
+		wake:  make(chan struct{}, 1),
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Schedule runs fn once at the given time. A time that has already passed runs fn as soon as possible.
+// After Stop, fn is never run and the zero Handle is returned.
+// Time complexity: O(log n) where n is the number of pending tasks.
+//
+// Parameters:
+//   - at: when to run fn
+//   - fn: the callback to run
+//
+// Returns:
+//   - a handle for Cancel, Reschedule and Next
+//
+// Example:
+//
+//	h := s.Schedule(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), sendReport)
+func (s *Scheduler) Schedule(at time.Time, fn func()) Handle {
+	return s.add(&task{fn: fn}, at)
+}
+
+// After runs fn once after delay has elapsed on the scheduler's clock.
+// Time complexity: O(log n) where n is the number of pending tasks.
+//
+// Parameters:
+//   - delay: how long from now to wait before running fn
+//   - fn: the callback to run
+//
+// Returns:
+//   - a handle for Cancel, Reschedule and Next
+//
+// Example:
+//
+//	h := s.After(30*time.Second, func() { conn.Close() })
+func (s *Scheduler) After(delay time.Duration, fn func()) Handle {
+	return s.Schedule(s.clock.Now().Add(delay), fn)
+}
+
+// Every runs fn repeatedly, first after interval has elapsed and then every interval after that.
+// Run times stay on the original grid; if the dispatcher falls behind, missed runs are skipped
+// rather than run back to back. The task runs until it is cancelled or the scheduler stops.
+// Time complexity: O(log n) where n is the number of pending tasks.
+//
+// Parameters:
+//   - interval: the time between runs, must be positive
+//   - fn: the callback to run
+//
+// Returns:
+//   - a handle for Cancel, Reschedule and Next
+//
+// Panics if interval is zero or negative.
+//
+// Example:
+//
+//	h := s.Every(10*time.Second, heartbeat)
+//	defer s.Cancel(h)
+func (s *Scheduler) Every(interval time.Duration, fn func()) Handle {
+	if interval <= 0 {
+		panic(fmt.Sprintf("scheduler: Every: interval %v must be positive", interval))
+	}
+	return s.add(&task{fn: fn, interval: interval}, s.clock.Now().Add(interval))
+}
+
+// Cancel removes the task identified by h so that it does not run again.
+// A one-shot task that is already running or has run cannot be cancelled.
+// Time complexity: O(log n) where n is the number of pending tasks.
+//
+// Parameters:
+//   - h: the handle returned when the task was scheduled
+//
+// Returns:
+//   - true if a pending run was cancelled, false otherwise
+//
+// Example:
+//
+//	if s.Cancel(timeout) {
+//	    fmt.Println("request finished before its timeout")
+//	}
+func (s *Scheduler) Cancel(h Handle) bool {
+	if h.task == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.tasks.Remove(h.task.handle)
+	return ok
+}
+
+// Reschedule moves the next run of the task identified by h to the given time.
+// A recurring task continues every interval from the new time.
+// Time complexity: O(log n) where n is the number of pending tasks.
+//
+// Parameters:
+//   - h: the handle returned when the task was scheduled
+//   - at: the new time of the task's next run
+//
+// Returns:
+//   - true if the task was pending and has been moved, false otherwise
+//
+// Example:
+//
+//	s.Reschedule(idleTimeout, time.Now().Add(5*time.Minute))  // connection saw activity
+func (s *Scheduler) Reschedule(h Handle, at time.Time) bool {
+	if h.task == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.tasks.UpdatePriority(h.task.handle, at) {
+		return false
+	}
+	if s.tasks.Peek() == h.task {
+		s.notify()
+	}
+	return true
+}
+
+// Next returns the time of the next run of the task identified by h.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - h: the handle returned when the task was scheduled
+//
+// Returns:
+//   - at: the time of the next run, or the zero time if the task is not pending
+//   - ok: true if the task is pending, false otherwise
+func (s *Scheduler) Next(h Handle) (time.Time, bool) {
+	if h.task == nil {
+		return time.Time{}, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.tasks.Contains(h.task.handle) {
+		return time.Time{}, false
+	}
+	return h.task.handle.Priority(), true
+}
+
+// Size returns the number of pending tasks.
+// Time complexity: O(1).
+func (s *Scheduler) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tasks.Size()
+}
+
+// Stop ends the dispatcher goroutine and discards every pending task.
+// It waits for a callback that is currently running to return, so it must not be called from a callback.
+// Calling Stop more than once is a no-op.
+// Time complexity: O(n) where n is the number of pending tasks.
+//
+// Example:
+//
+//	s := New()
+//	defer s.Stop()
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	if !s.stopped {
+		s.stopped = true
+		s.tasks.Clear()
+		close(s.stop)
+	}
+	s.mu.Unlock()
+	<-s.done
+}
+
+// add queues t to run at the given time and returns its handle.
+func (s *Scheduler) add(t *task, at time.Time) Handle {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return Handle{}
+	}
+	t.handle = s.tasks.EnqueueHandle(t, at)
+	if s.tasks.Peek() == t {
+		s.notify()
+	}
+	return Handle{task: t}
+}
+
+// notify wakes the dispatcher without blocking. A pending wakeup already covers this one.
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run is the dispatcher loop. It runs the earliest task once it is due, requeueing recurring tasks
+// before their callback runs, and otherwise waits for the earliest run time, a wakeup or Stop.
+func (s *Scheduler) run() {
+	defer close(s.done)
+	for {
+		select {
+		case <-s.stop:
+			return
+		default:
+		}
+
+		s.mu.Lock()
+		var fn func()
+		var timer clock.Timer
+		var due <-chan time.Time
+		if next, ok := s.tasks.TryPeek(); ok {
+			at := next.handle.Priority()
+			if now := s.clock.Now(); !at.After(now) {
+				s.tasks.Dequeue()
+				if next.interval > 0 {
+					missed := now.Sub(at) / next.interval
+					next.handle = s.tasks.EnqueueHandle(next, at.Add((missed+1)*next.interval))
+				}
+				fn = next.fn
+			} else {
+				timer = s.clock.NewTimerAt(at)
+				due = timer.C()
+			}
+		}
+		s.mu.Unlock()
+
+		if fn != nil {
+			fn()
+			continue
+		}
+		select {
+		case <-due:
+		case <-s.wake:
+		case <-s.stop:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package scheduler
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thefrost13/gollections/clock"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newTestScheduler returns a scheduler driven by a fake clock that is stopped when the test ends.
+func newTestScheduler(t *testing.T) (*Scheduler, *clock.Fake) {
+	fake := clock.NewFake(epoch)
+	s := NewWithClock(fake)
+	t.Cleanup(s.Stop)
+	return s, fake
+}
+
+// receive waits for a value on ch, failing the test if none arrives within a second.
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case value := <-ch:
+		return value
+	case <-time.After(time.Second):
+		t.Fatal("Expected callback to run")
+		var zero T
+		return zero
+	}
+}
+
+// expectNone fails the test if a value arrives on ch within a short time.
+func expectNone[T any](t *testing.T, ch <-chan T) {
+	t.Helper()
+	select {
+	case value := <-ch:
+		t.Fatalf("Expected no callback, got %v", value)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestSchedulerSchedule(t *testing.T) {
+	t.Run("runs in time order", func(t *testing.T) {
+		s, fake := newTestScheduler(t)
+		ran := make(chan string)
+		record := func(name string) func() {
+			return func() { ran <- name }
+		}
+		s.Schedule(epoch.Add(3*time.Minute), record("c"))
+		s.Schedule(epoch.Add(time.Minute), record("a"))
+		s.After(2*time.Minute, record("b"))
+		s.After(2*time.Minute, record("b2"))
+		if s.Size() != 4 {
+			t.Errorf("Expected 4 pending tasks, got %d", s.Size())
+		}
+
+		fake.BlockUntil(1)
+		fake.Advance(59 * time.Second)
+		expectNone(t, ran)
+
+		fake.Advance(2*time.Minute + time.Second)
+		var order []string
+		for i := 0; i < 4; i++ {
+			order = append(order, receive(t, ran))
+		}
+		if !reflect.DeepEqual(order, []string{"a", "b", "b2", "c"}) {
+			t.Errorf("Expected [a b b2 c], got %v", order)
+		}
+		if s.Size() != 0 {
+			t.Errorf("Expected no pending tasks, got %d", s.Size())
+		}
+	})
+
+	t.Run("past time runs immediately", func(t *testing.T) {
+		s, _ := newTestScheduler(t)
+		ran := make(chan struct{})
+		s.Schedule(epoch.Add(-time.Hour), func() { close(ran) })
+		receive(t, ran)
+	})
+
+	t.Run("callbacks can schedule", func(t *testing.T) {
+		s, fake := newTestScheduler(t)
+		ran := make(chan time.Time)
+		s.After(time.Minute, func() {
+			s.After(time.Minute, func() { ran <- fake.Now() })
+		})
+		fake.BlockUntil(1)
+		fake.Advance(time.Minute)
+		fake.BlockUntil(1)
+		fake.Advance(time.Minute)
+		if now := receive(t, ran); !now.Equal(epoch.Add(2 * time.Minute)) {
+			t.Errorf("Expected nested task at %v, got %v", epoch.Add(2*time.Minute), now)
+		}
+	})
+
+	t.Run("system clock", func(t *testing.T) {
+		s := New()
+		defer s.Stop()
+		start := time.Now()
+		ran := make(chan time.Time)
+		s.After(20*time.Millisecond, func() { ran <- time.Now() })
+		if elapsed := receive(t, ran).Sub(start); elapsed < 20*time.Millisecond {
+			t.Errorf("Expected task to wait at least 20ms, waited %v", elapsed)
+		}
+	})
+}
+
+func TestSchedulerEvery(t *testing.T) {
+	t.Run("runs on a fixed grid", func(t *testing.T) {
+		s, fake := newTestScheduler(t)
+		ran := make(chan time.Time)
+		h := s.Every(time.Minute, func() { ran <- fake.Now() })
+
+		for i := 1; i <= 2; i++ {
+			fake.BlockUntil(1)
+			fake.Advance(time.Minute)
+			if now := receive(t, ran); !now.Equal(epoch.Add(time.Duration(i) * time.Minute)) {
+				t.Errorf("Expected run %d at %v, got %v", i, epoch.Add(time.Duration(i)*time.Minute), now)
+			}
+		}
+
+		fake.BlockUntil(1)
+		fake.Advance(5*time.Minute + 30*time.Second)
+		receive(t, ran)
+		if next, ok := s.Next(h); !ok || !next.Equal(epoch.Add(8*time.Minute)) {
+			t.Errorf("Expected missed runs to be skipped and next run at %v, got (%v, %t)", epoch.Add(8*time.Minute), next, ok)
+		}
+		expectNone(t, ran)
+	})
+
+	t.Run("cancel from callback", func(t *testing.T) {
+		s, fake := newTestScheduler(t)
+		var runs atomic.Int32
+		ran := make(chan struct{})
+		var h Handle
+		h = s.Every(time.Second, func() {
+			if runs.Add(1) == 2 {
+				if !s.Cancel(h) {
+					t.Error("Expected recurring task to be cancellable from its callback")
+				}
+			}
+			ran <- struct{}{}
+		})
+		for i := 0; i < 2; i++ {
+			fake.BlockUntil(1)
+			fake.Advance(time.Second)
+			receive(t, ran)
+		}
+		fake.Advance(time.Hour)
+		expectNone(t, ran)
+		if runs.Load() != 2 || s.Size() != 0 {
+			t.Errorf("Expected 2 runs and no pending tasks, got %d and %d", runs.Load(), s.Size())
+		}
+	})
+
+	t.Run("non-positive interval panics", func(t *testing.T) {
+		s, _ := newTestScheduler(t)
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected Every to panic")
+			}
+		}()
+		s.Every(0, func() {})
+	})
+}
+
+func TestSchedulerCancelReschedule(t *testing.T) {
+	t.Run("cancel", func(t *testing.T) {
+		s, fake := newTestScheduler(t)
+		ran := make(chan struct{})
+		h := s.After(time.Minute, func() { close(ran) })
+		if !s.Cancel(h) {
+			t.Error("Expected pending task to be cancelled")
+		}
+		if s.Cancel(h) || s.Cancel(Handle{}) {
+			t.Error("Expected cancelling a removed task or the zero Handle to fail")
+		}
+		if _, ok := s.Next(h); ok {
+			t.Error("Expected cancelled task to have no next run")
+		}
+		fake.Advance(time.Hour)
+		expectNone(t, ran)
+	})
+
+	t.Run("reschedule earlier", func(t *testing.T) {
+		s, fake := newTestScheduler(t)
+		ran := make(chan struct{})
+		h := s.After(time.Hour, func() { close(ran) })
+		fake.BlockUntil(1)
+		if !s.Reschedule(h, epoch) {
+			t.Fatal("Expected pending task to be rescheduled")
+		}
+		receive(t, ran)
+		if s.Reschedule(h, epoch.Add(time.Hour)) {
+			t.Error("Expected rescheduling a task that already ran to fail")
+		}
+	})
+
+	t.Run("reschedule later", func(t *testing.T) {
+		s, fake := newTestScheduler(t)
+		ran := make(chan struct{})
+		h := s.After(time.Minute, func() { close(ran) })
+		fake.BlockUntil(1)
+		if !s.Reschedule(h, epoch.Add(time.Hour)) {
+			t.Fatal("Expected pending task to be rescheduled")
+		}
+		if next, ok := s.Next(h); !ok || !next.Equal(epoch.Add(time.Hour)) {
+			t.Errorf("Expected next run at %v, got (%v, %t)", epoch.Add(time.Hour), next, ok)
+		}
+		fake.Advance(time.Minute)
+		expectNone(t, ran)
+		fake.Advance(59 * time.Minute)
+		receive(t, ran)
+	})
+
+	t.Run("zero handle", func(t *testing.T) {
+		s, _ := newTestScheduler(t)
+		if s.Reschedule(Handle{}, epoch) {
+			t.Error("Expected rescheduling the zero Handle to fail")
+		}
+		if _, ok := s.Next(Handle{}); ok {
+			t.Error("Expected the zero Handle to have no next run")
+		}
+	})
+}
+
+func TestSchedulerStop(t *testing.T) {
+	fake := clock.NewFake(epoch)
+	s := NewWithClock(fake)
+	ran := make(chan struct{})
+	s.After(time.Minute, func() { close(ran) })
+	s.Stop()
+	s.Stop()
+
+	if s.Size() != 0 {
+		t.Errorf("Expected pending tasks to be discarded, got %d", s.Size())
+	}
+	if h := s.After(0, func() { close(ran) }); h != (Handle{}) {
+		t.Error("Expected Schedule after Stop to return the zero Handle")
+	}
+	fake.Advance(time.Hour)
+	expectNone(t, ran)
+
+	system := NewWithClock(nil)
+	defer system.Stop()
+	if system.clock != clock.System() {
+		t.Error("Expected nil clock to use the system clock")
+	}
+}
+
+func TestSchedulerConcurrent(t *testing.T) {
+	const goroutines = 8
+	const perGoroutine = 200
+
+	s := New()
+	defer s.Stop()
+	var ran sync.WaitGroup
+	var wg sync.WaitGroup
+	ran.Add(goroutines * perGoroutine)
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				h := s.After(time.Duration(i%5)*time.Millisecond, ran.Done)
+				if i%3 == 0 && s.Cancel(h) {
+					ran.Done()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	done := make(chan struct{})
+	go func() {
+		ran.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected every task to run or be cancelled")
+	}
+	if s.Size() != 0 {
+		t.Errorf("Expected no pending tasks, got %d", s.Size())
+	}
+}
+
+// Benchmark tests
+
+func BenchmarkScheduleCancel(b *testing.B) {
+	b.Run("Scheduler", func(b *testing.B) {
+		s := New()
+		defer s.Stop()
+		for i := 0; i < b.N; i++ {
+			s.Cancel(s.After(time.Hour, func() {}))
+		}
+	})
+
+	b.Run("AfterFunc", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			time.AfterFunc(time.Hour, func() {}).Stop()
+		}
+	})
+}
+
+func BenchmarkSchedulePending(b *testing.B) {
+	const pending = 10000
+
+	b.Run("Scheduler", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s := New()
+			for j := 0; j < pending; j++ {
+				s.After(time.Hour+time.Duration(j)*time.Millisecond, func() {})
+			}
+			s.Stop()
+		}
+	})
+
+	b.Run("AfterFunc", func(b *testing.B) {
+		timers := make([]*time.Timer, pending)
+		for i := 0; i < b.N; i++ {
+			for j := range timers {
+				timers[j] = time.AfterFunc(time.Hour+time.Duration(j)*time.Millisecond, func() {})
+			}
+			for _, timer := range timers {
+				timer.Stop()
+			}
+		}
+	})
+}
@@ -0,0 +1,339 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// concurrentNode is an element of a ConcurrentSkipList, linked into the first len(next) levels.
+// The key never changes after the node is published; value and links are read atomically.
+type concurrentNode[K, V any] struct {
+	key   K                                      // the key stored in the node
+	value atomic.Pointer[V]                      // the value associated with the key
+	next  []atomic.Pointer[concurrentNode[K, V]] // successor at each level
+}
+
+// ConcurrentSkipList is a sorted map that is safe for concurrent use by multiple goroutines.
+// Reads never lock: Search, Contains, First and the iterators traverse atomically published links,
+// so they proceed in parallel with each other and with a writer.
+// Writers (Insert, GetOrInsert, Delete and Clear) are serialized by a mutex.
+//
+// A new node is linked bottom-up and a removed node is unlinked top-down, without changing its own links,
+// so a reader always observes a sorted list. A read that overlaps a write may or may not observe it.
+// A ConcurrentSkipList must be created with NewConcurrent or NewConcurrentFunc.
+//
+// Type parameters:
+//   - K: the key type, ordered by the list's compare function
+//   - V: the value type, can be any type
+type ConcurrentSkipList[K, V any] struct {
+	mu      sync.Mutex              // serializes writers
+	head    *concurrentNode[K, V]   // sentinel linked into every level
+	level   atomic.Int32            // number of levels currently in use
+	size    atomic.Int64            // number of elements
+	compare func(a, b K) int        // the ordering of keys
+	levels  levelGenerator          // draws the level of new nodes, guarded by mu
+	update  []*concurrentNode[K, V] // scratch space for the predecessors of a key, guarded by mu
+}
+
+// NewConcurrent creates and returns a new empty ConcurrentSkipList ordering keys with cmp.Compare.
+// Time complexity: O(m) where m is the maximum level.
+//
+// Parameters:
+//   - opts: options configuring the level probability, maximum level and seed
+//
+// Returns:
+//   - a new empty ConcurrentSkipList
+//
+// Example:
+//
+//	index := NewConcurrent[string, int]()
+//	go index.Insert("a", 1)
+//	index.Search("a")
+func NewConcurrent[K cmp.Ordered, V any](opts ...Option) *ConcurrentSkipList[K, V] {
+	return NewConcurrentFunc[K, V](cmp.Compare[K], opts...)
+}
+
+// NewConcurrentFunc creates and returns a new empty ConcurrentSkipList ordering keys with the given compare function.
+// Time complexity: O(m) where m is the maximum level.
+//
+// Parameters:
+//   - compare: the function used to order keys
+//   - opts: options configuring the level probability, maximum level and seed
+//
+// Returns:
+//   - a new empty ConcurrentSkipList
+func NewConcurrentFunc[K, V any](compare func(a, b K) int, opts ...Option) *ConcurrentSkipList[K, V] {
+	levels := newLevelGenerator(opts)
+	return &ConcurrentSkipList[K, V]{
+		head:    &concurrentNode[K, V]{next: make([]atomic.Pointer[concurrentNode[K, V]], levels.maxLevel)},
+		compare: compare,
+		levels:  levels,
+		update:  make([]*concurrentNode[K, V], levels.maxLevel),
+	}
+}
+
+// Insert inserts or updates a key-value pair in the ConcurrentSkipList.
+// Time complexity: O(log n) expected, where n is the number of elements.
+//
+// Parameters:
+//   - key: the key to insert or update
+//   - value: the value to associate with the key
+func (sl *ConcurrentSkipList[K, V]) Insert(key K, value V) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	defer clear(sl.update)
+	if x := sl.findPredecessors(key); x != nil {
+		x.value.Store(&value)
+		return
+	}
+	sl.link(key, value)
+}
+
+// GetOrInsert returns the existing value for key if present.
+// Otherwise it inserts value and returns it. The lookup and the insertion happen atomically.
+// Time complexity: O(log n) expected, where n is the number of elements.
+//
+// Parameters:
+//   - key: the key to look up or insert
+//   - value: the value to store if key is absent
+//
+// Returns:
+//   - actual: the existing value if key was present, otherwise value
+//   - loaded: true if the value was already present, false if it was stored
+//
+// Example:
+//
+//	counter, loaded := index.GetOrInsert(name, new(atomic.Int64))
+func (sl *ConcurrentSkipList[K, V]) GetOrInsert(key K, value V) (actual V, loaded bool) {
+	if existing, ok := sl.Search(key); ok {
+		return existing, true
+	}
+
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	defer clear(sl.update)
+	if x := sl.findPredecessors(key); x != nil {
+		return *x.value.Load(), true
+	}
+	sl.link(key, value)
+	return value, false
+}
+
+// Search retrieves the value associated with the given key without locking.
+// Time complexity: O(log n) expected, where n is the number of elements.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - value: the value associated with the key, or zero value if key not found
+//   - ok: true if the key exists in the list, false otherwise
+func (sl *ConcurrentSkipList[K, V]) Search(key K) (V, bool) {
+	if x := sl.seek(key); x != nil && sl.compare(x.key, key) == 0 {
+		return *x.value.Load(), true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether the given key exists in the ConcurrentSkipList, without locking.
+// Time complexity: O(log n) expected, where n is the number of elements.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - true if the key exists in the list, false otherwise
+func (sl *ConcurrentSkipList[K, V]) Contains(key K) bool {
+	x := sl.seek(key)
+	return x != nil && sl.compare(x.key, key) == 0
+}
+
+// Delete removes the key-value pair with the given key.
+// Time complexity: O(log n) expected, where n is the number of elements.
+//
+// Parameters:
+//   - key: the key to remove
+//
+// Returns:
+//   - true if the key was present, false otherwise
+func (sl *ConcurrentSkipList[K, V]) Delete(key K) bool {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	defer clear(sl.update)
+
+	x := sl.findPredecessors(key)
+	if x == nil {
+		return false
+	}
+	for i := len(x.next) - 1; i >= 0; i-- {
+		sl.update[i].next[i].Store(x.next[i].Load())
+	}
+	level := sl.level.Load()
+	for level > 0 && sl.head.next[level-1].Load() == nil {
+		level--
+	}
+	sl.level.Store(level)
+	sl.size.Add(-1)
+	return true
+}
+
+// First returns the entry with the smallest key, without locking.
+// Time complexity: O(1).
+//
+// Returns:
+//   - key: the smallest key, or zero value if the list is empty
+//   - value: its value, or zero value if the list is empty
+//   - ok: true if the list is not empty, false otherwise
+func (sl *ConcurrentSkipList[K, V]) First() (K, V, bool) {
+	if x := sl.head.next[0].Load(); x != nil {
+		return x.key, *x.value.Load(), true
+	}
+	var key K
+	var value V
+	return key, value, false
+}
+
+// Size returns the number of elements in the ConcurrentSkipList.
+// Time complexity: O(1).
+func (sl *ConcurrentSkipList[K, V]) Size() int {
+	return int(sl.size.Load())
+}
+
+// IsEmpty returns true if the ConcurrentSkipList contains no elements.
+// Time complexity: O(1).
+func (sl *ConcurrentSkipList[K, V]) IsEmpty() bool {
+	return sl.Size() == 0
+}
+
+// Clear removes all elements from the ConcurrentSkipList.
+// Readers already traversing the list finish over the elements they can still reach.
+// Time complexity: O(m) where m is the maximum level.
+func (sl *ConcurrentSkipList[K, V]) Clear() {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	for i := range sl.head.next {
+		sl.head.next[i].Store(nil)
+	}
+	sl.level.Store(0)
+	sl.size.Store(0)
+}
+
+// Keys returns all keys in ascending order.
+// Time complexity: O(n) where n is the number of elements.
+func (sl *ConcurrentSkipList[K, V]) Keys() []K {
+	var keys []K
+	for key := range sl.All() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns all values in ascending order of their keys.
+// Time complexity: O(n) where n is the number of elements.
+func (sl *ConcurrentSkipList[K, V]) Values() []V {
+	var values []V
+	for _, value := range sl.All() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// ToSlice returns all key-value pairs in ascending key order.
+// Time complexity: O(n) where n is the number of elements.
+func (sl *ConcurrentSkipList[K, V]) ToSlice() []KVPair[K, V] {
+	var pairs []KVPair[K, V]
+	for key, value := range sl.All() {
+		pairs = append(pairs, KVPair[K, V]{Key: key, Value: value})
+	}
+	return pairs
+}
+
+// All returns an iterator over the entries in ascending key order, without locking.
+// The iteration is weakly consistent: the list may be modified during iteration,
+// and changes made concurrently may or may not be observed, but keys are always yielded in ascending order.
+// Time complexity: O(n) for a full iteration.
+//
+// Example:
+//
+//	for key, value := range index.All() {
+//	    index.Delete(key)  // safe: the loop body may modify the list
+//	}
+func (sl *ConcurrentSkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := sl.head.next[0].Load(); x != nil; x = x.next[0].Load() {
+			if !yield(x.key, *x.value.Load()) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the entries whose keys lie in the half-open interval [from, to),
+// in ascending key order, without locking. The iteration is weakly consistent, as for All.
+// Time complexity: O(log n + k) expected, where k is the number of entries yielded.
+func (sl *ConcurrentSkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if sl.compare(from, to) >= 0 {
+			return
+		}
+		for x := sl.seek(from); x != nil && sl.compare(x.key, to) < 0; x = x.next[0].Load() {
+			if !yield(x.key, *x.value.Load()) {
+				return
+			}
+		}
+	}
+}
+
+// seek returns the first node whose key is not less than key, or nil if there is none.
+func (sl *ConcurrentSkipList[K, V]) seek(key K) *concurrentNode[K, V] {
+	x := sl.head
+	for i := int(sl.level.Load()) - 1; i >= 0; i-- {
+		for next := x.next[i].Load(); next != nil && sl.compare(next.key, key) < 0; next = x.next[i].Load() {
+			x = next
+		}
+	}
+	return x.next[0].Load()
+}
+
+// findPredecessors records in sl.update the last node before key at each level in use
+// and returns the node holding key, or nil if it is absent. The caller must hold sl.mu.
+func (sl *ConcurrentSkipList[K, V]) findPredecessors(key K) *concurrentNode[K, V] {
+	x := sl.head
+	for i := int(sl.level.Load()) - 1; i >= 0; i-- {
+		for next := x.next[i].Load(); next != nil && sl.compare(next.key, key) < 0; next = x.next[i].Load() {
+			x = next
+		}
+		sl.update[i] = x
+	}
+	if next := x.next[0].Load(); next != nil && sl.compare(next.key, key) == 0 {
+		return next
+	}
+	return nil
+}
+
+// link inserts a new node after the predecessors recorded by findPredecessors. The caller must hold sl.mu.
+// The node's own links are set before it is published, and it is published from the bottom level up,
+// so a reader that reaches it at any level can continue from it.
+func (sl *ConcurrentSkipList[K, V]) link(key K, value V) {
+	level := sl.levels.next()
+	inUse := int(sl.level.Load())
+	for i := inUse; i < level; i++ {
+		sl.update[i] = sl.head
+	}
+
+	n := &concurrentNode[K, V]{key: key, next: make([]atomic.Pointer[concurrentNode[K, V]], level)}
+	n.value.Store(&value)
+	for i := range level {
+		n.next[i].Store(sl.update[i].next[i].Load())
+	}
+	for i := range level {
+		sl.update[i].next[i].Store(n)
+	}
+	if level > inUse {
+		sl.level.Store(int32(level))
+	}
+	sl.size.Add(1)
+}
@@ -0,0 +1,226 @@
+package skiplist
+
+import (
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/thefrost13/gollections/orderedhashmap"
+)
+
+func TestConcurrentSkipListOperations(t *testing.T) {
+	sl := NewConcurrent[string, int](WithSeed(1))
+	if !sl.IsEmpty() {
+		t.Error("Expected new list to be empty")
+	}
+	if _, _, ok := sl.First(); ok {
+		t.Error("Expected First on empty list to fail")
+	}
+
+	sl.Insert("b", 2)
+	sl.Insert("a", 1)
+	sl.Insert("a", 10)
+	if actual, loaded := sl.GetOrInsert("a", 100); !loaded || actual != 10 {
+		t.Errorf("Expected (10, true), got (%d, %t)", actual, loaded)
+	}
+	if actual, loaded := sl.GetOrInsert("c", 3); loaded || actual != 3 {
+		t.Errorf("Expected (3, false), got (%d, %t)", actual, loaded)
+	}
+	if value, ok := sl.Search("b"); !ok || value != 2 {
+		t.Errorf("Expected (2, true), got (%d, %t)", value, ok)
+	}
+	if _, ok := sl.Search("z"); ok || sl.Contains("z") {
+		t.Error("Expected missing key to be absent")
+	}
+	if key, value, ok := sl.First(); !ok || key != "a" || value != 10 {
+		t.Errorf("Expected (a, 10, true), got (%s, %d, %t)", key, value, ok)
+	}
+
+	if !reflect.DeepEqual(sl.Keys(), []string{"a", "b", "c"}) {
+		t.Errorf("Unexpected keys %v", sl.Keys())
+	}
+	if !reflect.DeepEqual(sl.Values(), []int{10, 2, 3}) {
+		t.Errorf("Unexpected values %v", sl.Values())
+	}
+	if pairs := sl.ToSlice(); len(pairs) != 3 || pairs[1] != (KVPair[string, int]{Key: "b", Value: 2}) {
+		t.Errorf("Unexpected pairs %v", pairs)
+	}
+	var ranged []string
+	for key := range sl.Range("b", "z") {
+		ranged = append(ranged, key)
+	}
+	if !reflect.DeepEqual(ranged, []string{"b", "c"}) {
+		t.Errorf("Expected [b c], got %v", ranged)
+	}
+	for range sl.Range("z", "a") {
+		t.Error("Expected empty range to yield nothing")
+	}
+
+	if !sl.Delete("b") || sl.Delete("b") {
+		t.Error("Expected only the first Delete to succeed")
+	}
+	for key := range sl.All() {
+		sl.Delete(key) // modifying during iteration is allowed
+	}
+	if sl.Size() != 0 || sl.Keys() != nil {
+		t.Errorf("Expected list to be empty, got %v", sl.Keys())
+	}
+
+	for i := range 50 {
+		sl.Insert(strconv.Itoa(i), i)
+	}
+	sl.Clear()
+	if !sl.IsEmpty() || sl.Contains("1") {
+		t.Error("Expected list to be empty after Clear")
+	}
+}
+
+func TestConcurrentSkipListConcurrent(t *testing.T) {
+	const writers = 8
+	const readers = 8
+	const keys = 500
+
+	sl := NewConcurrent[int, int]()
+	var inserted atomic.Int64
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	for r := 0; r < readers; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				prev := -1
+				for key, value := range sl.All() {
+					if key <= prev {
+						t.Errorf("Expected ascending keys, got %d after %d", key, prev)
+						return
+					}
+					if value != key {
+						t.Errorf("Expected value %d, got %d", key, value)
+						return
+					}
+					prev = key
+				}
+				for key := range sl.Range(100, 200) {
+					if key < 100 || key >= 200 {
+						t.Errorf("Expected key in [100, 200), got %d", key)
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	var writersWG sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		writersWG.Add(1)
+		go func(w int) {
+			defer writersWG.Done()
+			for i := 0; i < keys; i++ {
+				if _, loaded := sl.GetOrInsert(i, i); !loaded {
+					inserted.Add(1)
+				}
+				if i%writers == w {
+					sl.Delete(i)
+					sl.Insert(i, i)
+				}
+			}
+		}(w)
+	}
+	writersWG.Wait()
+	close(done)
+	wg.Wait()
+
+	if inserted.Load() != keys {
+		t.Errorf("Expected exactly %d first insertions, got %d", keys, inserted.Load())
+	}
+	if sl.Size() != keys || len(sl.Keys()) != keys {
+		t.Errorf("Expected %d keys, got size %d and %d keys", keys, sl.Size(), len(sl.Keys()))
+	}
+}
+
+// Benchmark tests
+func BenchmarkConcurrentSkipListInsert(b *testing.B) {
+	sl := NewConcurrent[int, int]()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sl.Insert(i, i)
+	}
+}
+
+// BenchmarkConcurrentReadHeavy compares parallel lookups with 1% writes against the same
+// skip list guarded by a sync.RWMutex, and against SyncOrderedHashMap.
+func BenchmarkConcurrentReadHeavy(b *testing.B) {
+	const n = 1 << 14
+
+	b.Run("ConcurrentSkipList", func(b *testing.B) {
+		sl := NewConcurrent[int, int]()
+		for i := 0; i < n; i++ {
+			sl.Insert(i, i)
+		}
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i%100 == 0 {
+					sl.Insert(i&(n-1), i)
+				} else {
+					sl.Search(i & (n - 1))
+				}
+				i++
+			}
+		})
+	})
+
+	b.Run("RWMutexSkipList", func(b *testing.B) {
+		var mu sync.RWMutex
+		sl := New[int, int]()
+		for i := 0; i < n; i++ {
+			sl.Insert(i, i)
+		}
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i%100 == 0 {
+					mu.Lock()
+					sl.Insert(i&(n-1), i)
+					mu.Unlock()
+				} else {
+					mu.RLock()
+					sl.Search(i & (n - 1))
+					mu.RUnlock()
+				}
+				i++
+			}
+		})
+	})
+
+	b.Run("SyncOrderedHashMap", func(b *testing.B) {
+		m := orderedhashmap.NewSync[int, int](nil)
+		for i := 0; i < n; i++ {
+			m.Set(i, i)
+		}
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i%100 == 0 {
+					m.Set(i&(n-1), i)
+				} else {
+					m.Get(i & (n - 1))
+				}
+				i++
+			}
+		})
+	})
+}
@@ -0,0 +1,433 @@
+// Package skiplist provides sorted maps implemented as skip lists.
+// SkipList is the single-goroutine variant; ConcurrentSkipList lets any number of
+// readers search and iterate without locking while writers are serialized.
+package skiplist
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+)
+
+const (
+	// DefaultProbability is the default chance that a node is promoted to the next level.
+	DefaultProbability = 0.25
+	// DefaultMaxLevel is the default maximum number of levels of a skip list.
+	DefaultMaxLevel = 32
+)
+
+// KVPair represents a key-value pair stored in a skip list.
+type KVPair[K, V any] struct {
+	Key   K // the key of the pair
+	Value V // the value associated with the key
+}
+
+// Option configures the level structure of a skip list at construction time.
+type Option func(*options)
+
+// options holds the settings collected from Option values.
+type options struct {
+	probability float64 // chance of promoting a node one level up
+	maxLevel    int     // maximum number of levels
+	seed        uint64  // seed of the level generator, valid if seeded
+	seeded      bool    // whether seed was set
+}
+
+// WithProbability sets the chance that a node is promoted to the next level.
+// Lower values use less memory per node at the cost of longer searches; 0.25 and 0.5 are typical.
+//
+// Panics if p is not in the open interval (0, 1).
+//
+// Example:
+//
+//	sl := New[int, string](WithProbability(0.5))
+func WithProbability(p float64) Option {
+	if !(p > 0 && p < 1) {
+		panic(fmt.Sprintf("skiplist: WithProbability: probability %v out of range (0, 1)", p))
+	}
+	return func(o *options) {
+		o.probability = p
+	}
+}
+
+// WithMaxLevel sets the maximum number of levels of the skip list.
+// A list with probability p and n levels stays efficient for about (1/p)^n elements.
+//
+// Panics if n is not in the range [1, 64].
+//
+// Example:
+//
+//	sl := New[int, string](WithMaxLevel(16))
+func WithMaxLevel(n int) Option {
+	if n < 1 || n > 64 {
+		panic(fmt.Sprintf("skiplist: WithMaxLevel: level %d out of range [1, 64]", n))
+	}
+	return func(o *options) {
+		o.maxLevel = n
+	}
+}
+
+// WithSeed seeds the random level generator, making the shape of the list reproducible.
+// Without it, each list is seeded randomly.
+//
+// Example:
+//
+//	sl := New[int, string](WithSeed(42))
+func WithSeed(seed uint64) Option {
+	return func(o *options) {
+		o.seed, o.seeded = seed, true
+	}
+}
+
+// levelGenerator draws random node levels with a geometric distribution.
+type levelGenerator struct {
+	rng         *rand.Rand // source of randomness
+	probability float64    // chance of promoting a node one level up
+	maxLevel    int        // maximum level returned
+}
+
+// newLevelGenerator applies opts over the defaults and returns the resulting generator.
+func newLevelGenerator(opts []Option) levelGenerator {
+	o := options{probability: DefaultProbability, maxLevel: DefaultMaxLevel}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if !o.seeded {
+		o.seed = rand.Uint64()
+	}
+	return levelGenerator{
+		rng:         rand.New(rand.NewPCG(o.seed, o.seed)),
+		probability: o.probability,
+		maxLevel:    o.maxLevel,
+	}
+}
+
+// next returns a random level in the range [1, maxLevel].
+func (g *levelGenerator) next() int {
+	level := 1
+	for level < g.maxLevel && g.rng.Float64() < g.probability {
+		level++
+	}
+	return level
+}
+
+// node is an element of a SkipList, linked into the first len(next) levels.
+type node[K, V any] struct {
+	key   K             // the key stored in the node
+	value V             // the value associated with the key
+	next  []*node[K, V] // successor at each level
+}
+
+// SkipList is a generic sorted map implemented as a skip list.
+// Each element is linked into a random number of levels, giving O(log n) expected time
+// for search, insertion and deletion, and O(1) steps between neighbors during ordered iteration.
+// SkipList is not safe for concurrent use; see ConcurrentSkipList.
+// A SkipList must be created with New or NewFunc.
+//
+// Type parameters:
+//   - K: the key type, ordered by the list's compare function
+//   - V: the value type, can be any type
+type SkipList[K, V any] struct {
+	head    *node[K, V]      // sentinel linked into every level
+	level   int              // number of levels currently in use
+	size    int              // number of elements
+	compare func(a, b K) int // the ordering of keys
+	levels  levelGenerator   // draws the level of new nodes
+	update  []*node[K, V]    // scratch space for the predecessors of a key
+}
+
+// New creates and returns a new empty SkipList ordering keys with cmp.Compare.
+// Time complexity: O(m) where m is the maximum level.
+//
+// Parameters:
+//   - opts: options configuring the level probability, maximum level and seed
+//
+// Returns:
+//   - a new empty SkipList
+//
+// Example:
+//
+//	sl := New[int, string]()
+//	sl.Insert(2, "two")
+//	sl.Insert(1, "one")
+//	sl.Keys()  // [1 2]
+func New[K cmp.Ordered, V any](opts ...Option) *SkipList[K, V] {
+	return NewFunc[K, V](cmp.Compare[K], opts...)
+}
+
+// NewFunc creates and returns a new empty SkipList ordering keys with the given compare function.
+// compare must return a negative number when a < b, zero when a and b are equivalent,
+// and a positive number when a > b.
+// Time complexity: O(m) where m is the maximum level.
+//
+// Parameters:
+//   - compare: the function used to order keys
+//   - opts: options configuring the level probability, maximum level and seed
+//
+// Returns:
+//   - a new empty SkipList
+//
+// Example:
+//
+//	sl := NewFunc[string, int](strings.Compare, WithProbability(0.5))
+func NewFunc[K, V any](compare func(a, b K) int, opts ...Option) *SkipList[K, V] {
+	levels := newLevelGenerator(opts)
+	return &SkipList[K, V]{
+		head:    &node[K, V]{next: make([]*node[K, V], levels.maxLevel)},
+		compare: compare,
+		levels:  levels,
+		update:  make([]*node[K, V], levels.maxLevel),
+	}
+}
+
+// Insert inserts or updates a key-value pair in the SkipList.
+// Time complexity: O(log n) expected, where n is the number of elements.
+//
+// Parameters:
+//   - key: the key to insert or update
+//   - value: the value to associate with the key
+//
+// Example:
+//
+//	sl.Insert("apple", 5)
+func (sl *SkipList[K, V]) Insert(key K, value V) {
+	defer clear(sl.update)
+	if x := sl.findPredecessors(key); x != nil {
+		x.value = value
+		return
+	}
+
+	level := sl.levels.next()
+	for i := sl.level; i < level; i++ {
+		sl.update[i] = sl.head
+	}
+	sl.level = max(sl.level, level)
+
+	n := &node[K, V]{key: key, value: value, next: make([]*node[K, V], level)}
+	for i := range level {
+		n.next[i] = sl.update[i].next[i]
+		sl.update[i].next[i] = n
+	}
+	sl.size++
+}
+
+// Search retrieves the value associated with the given key.
+// Time complexity: O(log n) expected, where n is the number of elements.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - value: the value associated with the key, or zero value if key not found
+//   - ok: true if the key exists in the list, false otherwise
+//
+// Example:
+//
+//	if value, ok := sl.Search("apple"); ok {
+//	    fmt.Println(value)
+//	}
+func (sl *SkipList[K, V]) Search(key K) (V, bool) {
+	if x := sl.seek(key); x != nil && sl.compare(x.key, key) == 0 {
+		return x.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether the given key exists in the SkipList.
+// Time complexity: O(log n) expected, where n is the number of elements.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - true if the key exists in the list, false otherwise
+func (sl *SkipList[K, V]) Contains(key K) bool {
+	x := sl.seek(key)
+	return x != nil && sl.compare(x.key, key) == 0
+}
+
+// Delete removes the key-value pair with the given key.
+// Time complexity: O(log n) expected, where n is the number of elements.
+//
+// Parameters:
+//   - key: the key to remove
+//
+// Returns:
+//   - true if the key was present, false otherwise
+func (sl *SkipList[K, V]) Delete(key K) bool {
+	defer clear(sl.update)
+	x := sl.findPredecessors(key)
+	if x == nil {
+		return false
+	}
+	for i := range x.next {
+		sl.update[i].next[i] = x.next[i]
+	}
+	for sl.level > 0 && sl.head.next[sl.level-1] == nil {
+		sl.level--
+	}
+	sl.size--
+	return true
+}
+
+// First returns the entry with the smallest key.
+// Time complexity: O(1).
+//
+// Returns:
+//   - key: the smallest key, or zero value if the list is empty
+//   - value: its value, or zero value if the list is empty
+//   - ok: true if the list is not empty, false otherwise
+func (sl *SkipList[K, V]) First() (K, V, bool) {
+	if x := sl.head.next[0]; x != nil {
+		return x.key, x.value, true
+	}
+	var key K
+	var value V
+	return key, value, false
+}
+
+// Size returns the number of elements in the SkipList.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the number of elements
+func (sl *SkipList[K, V]) Size() int {
+	return sl.size
+}
+
+// IsEmpty returns true if the SkipList contains no elements.
+// Time complexity: O(1).
+//
+// Returns:
+//   - true if the list is empty, false otherwise
+func (sl *SkipList[K, V]) IsEmpty() bool {
+	return sl.size == 0
+}
+
+// Clear removes all elements from the SkipList.
+// Time complexity: O(m) where m is the maximum level.
+func (sl *SkipList[K, V]) Clear() {
+	clear(sl.head.next)
+	sl.level = 0
+	sl.size = 0
+}
+
+// Keys returns all keys in ascending order.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - a slice of keys in ascending order
+func (sl *SkipList[K, V]) Keys() []K {
+	keys := make([]K, 0, sl.size)
+	for key := range sl.All() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns all values in ascending order of their keys.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - a slice of values ordered by key
+func (sl *SkipList[K, V]) Values() []V {
+	values := make([]V, 0, sl.size)
+	for _, value := range sl.All() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// ToSlice returns all key-value pairs in ascending key order.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - a slice of key-value pairs in ascending key order
+func (sl *SkipList[K, V]) ToSlice() []KVPair[K, V] {
+	pairs := make([]KVPair[K, V], 0, sl.size)
+	for key, value := range sl.All() {
+		pairs = append(pairs, KVPair[K, V]{Key: key, Value: value})
+	}
+	return pairs
+}
+
+// All returns an iterator over the entries in ascending key order.
+// The list must not be modified during iteration.
+// Time complexity: O(n) for a full iteration.
+//
+// Returns:
+//   - an iter.Seq2 yielding each key and its value in ascending key order
+//
+// Example:
+//
+//	for key, value := range sl.All() {
+//	    fmt.Printf("%v: %v\n", key, value)
+//	}
+func (sl *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := sl.head.next[0]; x != nil; x = x.next[0] {
+			if !yield(x.key, x.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the entries whose keys lie in the half-open interval [from, to),
+// in ascending key order. If from is not less than to, the iterator yields nothing.
+// The list must not be modified during iteration.
+// Time complexity: O(log n + k) expected, where k is the number of entries yielded.
+//
+// Parameters:
+//   - from: the inclusive lower bound
+//   - to: the exclusive upper bound
+//
+// Returns:
+//   - an iter.Seq2 yielding each key in [from, to) and its value
+//
+// Example:
+//
+//	for id, doc := range index.Range("user:", "user;") {
+//	    fmt.Println(id, doc)
+//	}
+func (sl *SkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if sl.compare(from, to) >= 0 {
+			return
+		}
+		for x := sl.seek(from); x != nil && sl.compare(x.key, to) < 0; x = x.next[0] {
+			if !yield(x.key, x.value) {
+				return
+			}
+		}
+	}
+}
+
+// seek returns the first node whose key is not less than key, or nil if there is none.
+func (sl *SkipList[K, V]) seek(key K) *node[K, V] {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for next := x.next[i]; next != nil && sl.compare(next.key, key) < 0; next = x.next[i] {
+			x = next
+		}
+	}
+	return x.next[0]
+}
+
+// findPredecessors records in sl.update the last node before key at each level in use
+// and returns the node holding key, or nil if it is absent.
+func (sl *SkipList[K, V]) findPredecessors(key K) *node[K, V] {
+	x := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for next := x.next[i]; next != nil && sl.compare(next.key, key) < 0; next = x.next[i] {
+			x = next
+		}
+		sl.update[i] = x
+	}
+	if next := x.next[0]; next != nil && sl.compare(next.key, key) == 0 {
+		return next
+	}
+	return nil
+}
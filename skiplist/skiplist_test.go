@@ -0,0 +1,498 @@
+package skiplist
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/thefrost13/gollections/orderedhashmap"
+)
+
+// checkInvariants verifies that every level is sorted, that each level is a sublist of the one below,
+// and that the level count and size are accurate.
+func checkInvariants[K, V any](t *testing.T, sl *SkipList[K, V]) {
+	t.Helper()
+	if sl.level > 0 && sl.head.next[sl.level-1] == nil {
+		t.Fatalf("Expected level %d to be in use", sl.level)
+	}
+	for i := sl.level; i < len(sl.head.next); i++ {
+		if sl.head.next[i] != nil {
+			t.Fatalf("Expected level %d above the level count %d to be empty", i, sl.level)
+		}
+	}
+
+	expected := 0
+	for i := 0; i < sl.level; i++ {
+		count := 0
+		for x := sl.head.next[i]; x != nil; x = x.next[i] {
+			if len(x.next) <= i {
+				t.Fatalf("Expected node %v linked at level %d to have more than %d levels", x.key, i, i)
+			}
+			if x.next[i] != nil && sl.compare(x.key, x.next[i].key) >= 0 {
+				t.Fatalf("Expected level %d to be sorted, got %v before %v", i, x.key, x.next[i].key)
+			}
+			count++
+		}
+		if i == 0 {
+			if count != sl.size {
+				t.Fatalf("Expected size %d, got %d", count, sl.size)
+			}
+		} else if count != expected {
+			t.Fatalf("Expected %d nodes at level %d, got %d", expected, i, count)
+		}
+		expected = 0
+		for x := sl.head.next[0]; x != nil; x = x.next[0] {
+			if len(x.next) > i+1 {
+				expected++
+			}
+		}
+	}
+}
+
+// recoverPanic runs fn and returns the value it panicked with, or nil.
+func recoverPanic(fn func()) (r any) {
+	defer func() { r = recover() }()
+	fn()
+	return nil
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		sl := New[int, int]()
+		if sl.levels.probability != DefaultProbability || sl.levels.maxLevel != DefaultMaxLevel {
+			t.Errorf("Expected defaults (%v, %d), got (%v, %d)",
+				DefaultProbability, DefaultMaxLevel, sl.levels.probability, sl.levels.maxLevel)
+		}
+	})
+
+	t.Run("Custom probability and max level", func(t *testing.T) {
+		sl := New[int, int](WithProbability(0.5), WithMaxLevel(4))
+		for i := range 1000 {
+			sl.Insert(i, i)
+		}
+		if sl.level > 4 || len(sl.head.next) != 4 {
+			t.Errorf("Expected at most 4 levels, got %d", sl.level)
+		}
+		checkInvariants(t, sl)
+	})
+
+	t.Run("Single level is a sorted linked list", func(t *testing.T) {
+		sl := New[int, int](WithMaxLevel(1))
+		for _, key := range []int{3, 1, 2} {
+			sl.Insert(key, key)
+		}
+		if sl.level != 1 || !reflect.DeepEqual(sl.Keys(), []int{1, 2, 3}) {
+			t.Errorf("Expected one level holding [1 2 3], got %d levels holding %v", sl.level, sl.Keys())
+		}
+	})
+
+	t.Run("Seed makes the shape reproducible", func(t *testing.T) {
+		shape := func() []int {
+			sl := New[int, int](WithSeed(7))
+			for i := range 200 {
+				sl.Insert(i, i)
+			}
+			var levels []int
+			for x := sl.head.next[0]; x != nil; x = x.next[0] {
+				levels = append(levels, len(x.next))
+			}
+			return levels
+		}
+		if !reflect.DeepEqual(shape(), shape()) {
+			t.Error("Expected equal seeds to produce equal level structures")
+		}
+	})
+
+	t.Run("Invalid options panic", func(t *testing.T) {
+		tests := []struct {
+			name string
+			fn   func()
+			msg  string
+		}{
+			{"zero probability", func() { WithProbability(0) }, "probability 0 out of range"},
+			{"probability of one", func() { WithProbability(1) }, "probability 1 out of range"},
+			{"zero max level", func() { WithMaxLevel(0) }, "level 0 out of range"},
+			{"max level above 64", func() { WithMaxLevel(65) }, "level 65 out of range"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				r := recoverPanic(tt.fn)
+				if r == nil || !strings.Contains(fmt.Sprint(r), tt.msg) {
+					t.Errorf("Expected panic containing %q, got %v", tt.msg, r)
+				}
+			})
+		}
+	})
+}
+
+func TestSkipListOperations(t *testing.T) {
+	t.Run("Insert and Search", func(t *testing.T) {
+		sl := New[string, int]()
+		if !sl.IsEmpty() {
+			t.Error("Expected new list to be empty")
+		}
+		sl.Insert("b", 2)
+		sl.Insert("a", 1)
+		sl.Insert("c", 3)
+
+		if sl.Size() != 3 {
+			t.Errorf("Expected size 3, got %d", sl.Size())
+		}
+		if value, ok := sl.Search("a"); !ok || value != 1 {
+			t.Errorf("Expected (1, true), got (%d, %t)", value, ok)
+		}
+		if value, ok := sl.Search("z"); ok || value != 0 {
+			t.Errorf("Expected (0, false), got (%d, %t)", value, ok)
+		}
+		if !sl.Contains("c") || sl.Contains("bb") {
+			t.Error("Expected Contains to report c present and bb absent")
+		}
+		if key, value, ok := sl.First(); !ok || key != "a" || value != 1 {
+			t.Errorf("Expected (a, 1, true), got (%s, %d, %t)", key, value, ok)
+		}
+	})
+
+	t.Run("Insert updates existing key", func(t *testing.T) {
+		sl := New[string, int]()
+		sl.Insert("a", 1)
+		sl.Insert("a", 10)
+		if sl.Size() != 1 {
+			t.Errorf("Expected size 1, got %d", sl.Size())
+		}
+		if value, _ := sl.Search("a"); value != 10 {
+			t.Errorf("Expected 10, got %d", value)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		sl := New[int, int]()
+		for i := range 20 {
+			sl.Insert(i, i)
+		}
+		if !sl.Delete(7) {
+			t.Error("Expected Delete of existing key to return true")
+		}
+		if sl.Delete(7) || sl.Delete(100) {
+			t.Error("Expected Delete of missing key to return false")
+		}
+		if sl.Size() != 19 || sl.Contains(7) {
+			t.Errorf("Expected 19 entries without 7, got %v", sl.Keys())
+		}
+		checkInvariants(t, sl)
+
+		for i := range 20 {
+			sl.Delete(i)
+		}
+		if !sl.IsEmpty() || sl.level != 0 {
+			t.Errorf("Expected empty list with no levels, got size %d and %d levels", sl.Size(), sl.level)
+		}
+		if _, _, ok := sl.First(); ok {
+			t.Error("Expected First on empty list to fail")
+		}
+	})
+
+	t.Run("Custom order", func(t *testing.T) {
+		sl := NewFunc[string, int](func(a, b string) int {
+			return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+		})
+		for _, key := range []string{"ccc", "a", "bb", "b"} {
+			sl.Insert(key, len(key))
+		}
+		if !reflect.DeepEqual(sl.Keys(), []string{"a", "b", "bb", "ccc"}) {
+			t.Errorf("Unexpected order %v", sl.Keys())
+		}
+		if !reflect.DeepEqual(sl.Values(), []int{1, 1, 2, 3}) {
+			t.Errorf("Unexpected values %v", sl.Values())
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		sl := New[int, int]()
+		for i := range 100 {
+			sl.Insert(i, i)
+		}
+		sl.Clear()
+		if !sl.IsEmpty() || len(sl.Keys()) != 0 {
+			t.Error("Expected list to be empty after Clear")
+		}
+		sl.Insert(1, 1)
+		if sl.Size() != 1 {
+			t.Errorf("Expected size 1 after reuse, got %d", sl.Size())
+		}
+		checkInvariants(t, sl)
+	})
+}
+
+func TestSkipListIterators(t *testing.T) {
+	sl := New[int, string]()
+	for _, key := range []int{3, 1, 4, 5, 9, 2, 6} {
+		sl.Insert(key, fmt.Sprint(key))
+	}
+
+	t.Run("All and ToSlice", func(t *testing.T) {
+		var keys []int
+		for key := range sl.All() {
+			keys = append(keys, key)
+		}
+		if !reflect.DeepEqual(keys, []int{1, 2, 3, 4, 5, 6, 9}) {
+			t.Errorf("Unexpected All order %v", keys)
+		}
+		pairs := sl.ToSlice()
+		if len(pairs) != 7 || pairs[6] != (KVPair[int, string]{Key: 9, Value: "9"}) {
+			t.Errorf("Unexpected pairs %v", pairs)
+		}
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		tests := []struct {
+			from, to int
+			expected []int
+		}{
+			{2, 6, []int{2, 3, 4, 5}},
+			{0, 100, []int{1, 2, 3, 4, 5, 6, 9}},
+			{7, 9, nil},
+			{6, 10, []int{6, 9}},
+			{5, 5, nil},
+			{6, 2, nil},
+		}
+		for _, tt := range tests {
+			var keys []int
+			for key := range sl.Range(tt.from, tt.to) {
+				keys = append(keys, key)
+			}
+			if !reflect.DeepEqual(keys, tt.expected) {
+				t.Errorf("Expected Range(%d, %d) = %v, got %v", tt.from, tt.to, tt.expected, keys)
+			}
+		}
+	})
+
+	t.Run("Early termination", func(t *testing.T) {
+		for _, seq := range []func(func(int, string) bool){sl.All(), sl.Range(0, 10)} {
+			count := 0
+			for range seq {
+				count++
+				break
+			}
+			if count != 1 {
+				t.Errorf("Expected 1 iteration, got %d", count)
+			}
+		}
+	})
+}
+
+func TestSkipListRandomized(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	sl := New[int, int](WithSeed(1))
+	model := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			_, present := model[key]
+			if deleted := sl.Delete(key); deleted != present {
+				t.Fatalf("Expected Delete(%d) = %t, got %t", key, present, deleted)
+			}
+			delete(model, key)
+		} else {
+			sl.Insert(key, i)
+			model[key] = i
+		}
+		if i%500 == 0 {
+			checkInvariants(t, sl)
+		}
+	}
+	checkInvariants(t, sl)
+
+	keys := make([]int, 0, len(model))
+	for key := range model {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	if !reflect.DeepEqual(sl.Keys(), keys) {
+		t.Fatalf("Expected keys %v, got %v", keys, sl.Keys())
+	}
+	for key, expected := range model {
+		if value, ok := sl.Search(key); !ok || value != expected {
+			t.Errorf("Expected Search(%d) = (%d, true), got (%d, %t)", key, expected, value, ok)
+		}
+	}
+}
+
+// Benchmark tests
+func BenchmarkSkipListInsert(b *testing.B) {
+	sl := New[int, int]()
+	rng := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sl.Insert(rng.Int(), i)
+	}
+}
+
+func BenchmarkSkipListSearch(b *testing.B) {
+	sl := New[int, int]()
+	for i := 0; i < 1<<16; i++ {
+		sl.Insert(i, i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sl.Search(i & (1<<16 - 1))
+	}
+}
+
+func BenchmarkSkipListDelete(b *testing.B) {
+	sl := New[int, int]()
+	for i := 0; i < b.N; i++ {
+		sl.Insert(i, i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sl.Delete(i)
+	}
+}
+
+// sortedSlice is the baseline ordered index: a slice of pairs kept sorted by binary insertion.
+type sortedSlice []KVPair[int, int]
+
+func (s *sortedSlice) insert(key, value int) {
+	i, found := slices.BinarySearchFunc(*s, key, func(p KVPair[int, int], key int) int { return cmp.Compare(p.Key, key) })
+	if found {
+		(*s)[i].Value = value
+		return
+	}
+	*s = slices.Insert(*s, i, KVPair[int, int]{Key: key, Value: value})
+}
+
+func (s sortedSlice) search(key int) (int, bool) {
+	i, found := slices.BinarySearchFunc(s, key, func(p KVPair[int, int], key int) int { return cmp.Compare(p.Key, key) })
+	if !found {
+		return 0, false
+	}
+	return s[i].Value, true
+}
+
+// sink keeps benchmark results alive.
+var sink int
+
+// indexSizes are the element counts used by the comparative benchmarks.
+var indexSizes = []int{1_000, 100_000}
+
+// BenchmarkIndexInsert builds an index of n random keys. OrderedHashMap inserts in O(1)
+// but keeps insertion order, while the sorted slice shifts O(n) elements per insertion.
+func BenchmarkIndexInsert(b *testing.B) {
+	for _, n := range indexSizes {
+		keys := rand.New(rand.NewSource(1)).Perm(n)
+
+		b.Run(fmt.Sprintf("SkipList/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sl := New[int, int]()
+				for _, key := range keys {
+					sl.Insert(key, key)
+				}
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/elem")
+		})
+		b.Run(fmt.Sprintf("OrderedHashMap/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ohm := orderedhashmap.New[int, int]()
+				for _, key := range keys {
+					ohm.Set(key, key)
+				}
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/elem")
+		})
+		b.Run(fmt.Sprintf("SortedSlice/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var s sortedSlice
+				for _, key := range keys {
+					s.insert(key, key)
+				}
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/elem")
+		})
+	}
+}
+
+// BenchmarkIndexSearch looks up random present keys.
+func BenchmarkIndexSearch(b *testing.B) {
+	for _, n := range indexSizes {
+		keys := rand.New(rand.NewSource(1)).Perm(n)
+		sl := New[int, int]()
+		ohm := orderedhashmap.New[int, int]()
+		var s sortedSlice
+		for _, key := range keys {
+			sl.Insert(key, key)
+			ohm.Set(key, key)
+			s.insert(key, key)
+		}
+
+		b.Run(fmt.Sprintf("SkipList/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sl.Search(keys[i%n])
+			}
+		})
+		b.Run(fmt.Sprintf("OrderedHashMap/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ohm.Get(keys[i%n])
+			}
+		})
+		b.Run(fmt.Sprintf("SortedSlice/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				s.search(keys[i%n])
+			}
+		})
+	}
+}
+
+// BenchmarkIndexRangeScan reads 100 consecutive keys in order. OrderedHashMap has no ordering,
+// so it must sort its keys first; this is the cost the ordered structures avoid.
+func BenchmarkIndexRangeScan(b *testing.B) {
+	const width = 100
+	for _, n := range indexSizes {
+		keys := rand.New(rand.NewSource(1)).Perm(n)
+		sl := New[int, int]()
+		ohm := orderedhashmap.New[int, int]()
+		var s sortedSlice
+		for _, key := range keys {
+			sl.Insert(key, key)
+			ohm.Set(key, key)
+			s.insert(key, key)
+		}
+
+		b.Run(fmt.Sprintf("SkipList/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				from := keys[i%n]
+				for _, value := range sl.Range(from, from+width) {
+					sink += value
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("OrderedHashMap/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				from := keys[i%n]
+				sorted := ohm.Keys()
+				slices.Sort(sorted)
+				start, _ := slices.BinarySearch(sorted, from)
+				for j := start; j < len(sorted) && sorted[j] < from+width; j++ {
+					value, _ := ohm.Get(sorted[j])
+					sink += value
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("SortedSlice/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				from := keys[i%n]
+				start, _ := slices.BinarySearchFunc(s, from, func(p KVPair[int, int], key int) int { return cmp.Compare(p.Key, key) })
+				for j := start; j < len(s) && s[j].Key < from+width; j++ {
+					sink += s[j].Value
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package stack
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+)
+
+// MarshalJSON implements json.Marshaler, encoding the stack as a JSON array.
+// Elements are listed from bottom to top, the order in which they were pushed,
+// so that New applied to the decoded array rebuilds the same stack.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - the JSON encoding of the stack
+//   - an error if an element cannot be marshaled
+//
+// Example:
+//
+//	s := New([]int{1, 2, 3})  // 3 on top
+//	data, _ := json.Marshal(s)  // [1,2,3]
+func (s *Stack[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.pushOrder())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It replaces the contents of the stack with the elements of a JSON array listed from bottom to top,
+// so the last array element ends up on top. A JSON null leaves the stack unchanged,
+// and if an error occurs the stack is left unchanged.
+// Time complexity: O(n) where n is the number of array elements.
+//
+// Parameters:
+//   - data: the JSON encoding of an array
+//
+// Returns:
+//   - an error if data is not an array of T
+//
+// Example:
+//
+//	s := New[int](nil)
+//	_ = json.Unmarshal([]byte(`[1,2,3]`), s)
+//	s.Pop()  // 3
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	*s = *New(elements)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using encoding/gob.
+// encoding/gob uses it as well, so a Stack can be sent with a gob.Encoder directly.
+// Elements are encoded from bottom to top, matching MarshalJSON.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - the binary encoding of the stack
+//   - an error if an element cannot be encoded by gob
+func (s *Stack[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.pushOrder()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the stack
+// with the elements decoded from data. If an error occurs the stack is left unchanged.
+// Time complexity: O(n) where n is the number of encoded elements.
+//
+// Parameters:
+//   - data: the output of MarshalBinary
+//
+// Returns:
+//   - an error if data is not a valid encoding
+func (s *Stack[T]) UnmarshalBinary(data []byte) error {
+	var elements []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements); err != nil {
+		return err
+	}
+	*s = *New(elements)
+	return nil
+}
+
+// pushOrder returns the elements from bottom to top.
+func (s *Stack[T]) pushOrder() []T {
+	elements := s.ToSlice()
+	slices.Reverse(elements)
+	return elements
+}
@@ -0,0 +1,179 @@
+package stack
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestStackJSON(t *testing.T) {
+	t.Run("Marshal lists elements from bottom to top", func(t *testing.T) {
+		s := New([]int{1, 2, 3})
+		data, err := json.Marshal(s)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if string(data) != "[1,2,3]" {
+			t.Errorf("Expected [1,2,3], got %s", data)
+		}
+		if s.Peek() != 3 {
+			t.Errorf("Expected marshaling not to modify the stack, top is %d", s.Peek())
+		}
+	})
+
+	t.Run("Unmarshal preserves LIFO order", func(t *testing.T) {
+		s := New([]string{"old"})
+		if err := json.Unmarshal([]byte(`["a","b","c"]`), s); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(s.ToSlice(), []string{"c", "b", "a"}) {
+			t.Errorf("Expected top-first [c b a], got %v", s.ToSlice())
+		}
+	})
+
+	t.Run("Empty stack and struct field", func(t *testing.T) {
+		var doc struct {
+			History *Stack[int] `json:"history"`
+		}
+		doc.History = New[int](nil)
+		data, err := json.Marshal(doc)
+		if err != nil || string(data) != `{"history":[]}` {
+			t.Errorf(`Expected {"history":[]}, got %s (%v)`, data, err)
+		}
+		if err := json.Unmarshal([]byte(`{"history":[4,5]}`), &doc); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if doc.History.Pop() != 5 {
+			t.Error("Expected 5 on top")
+		}
+	})
+
+	t.Run("null and errors leave the stack unchanged", func(t *testing.T) {
+		s := New([]int{1})
+		if err := s.UnmarshalJSON([]byte("null")); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if err := json.Unmarshal([]byte(`{"a":1}`), s); err == nil {
+			t.Error("Expected error for a JSON object")
+		}
+		if !reflect.DeepEqual(s.ToSlice(), []int{1}) {
+			t.Errorf("Expected [1], got %v", s.ToSlice())
+		}
+	})
+}
+
+func TestStackBinary(t *testing.T) {
+	t.Run("gob encoder uses the binary encoding", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(New([]int{1, 2, 3})); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		decoded := New[int](nil)
+		if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(decoded.ToSlice(), []int{3, 2, 1}) {
+			t.Errorf("Expected top-first [3 2 1], got %v", decoded.ToSlice())
+		}
+	})
+
+	t.Run("invalid data leaves the stack unchanged", func(t *testing.T) {
+		s := New([]int{1})
+		if err := s.UnmarshalBinary([]byte{0xff, 0x00}); err == nil {
+			t.Error("Expected error for invalid data")
+		}
+		if s.Size() != 1 || s.Peek() != 1 {
+			t.Errorf("Expected [1], got %v", s.ToSlice())
+		}
+	})
+}
+
+func TestSyncStackEncoding(t *testing.T) {
+	s := NewSync(New([]int{1, 2}))
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != "[1,2]" {
+		t.Errorf("Expected [1,2], got %s (%v)", data, err)
+	}
+	var decoded SyncStack[int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Peek() != 2 {
+		t.Errorf("Expected 2 on top, got %d", decoded.Peek())
+	}
+
+	data, err = s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var fromBinary SyncStack[int]
+	if err := fromBinary.UnmarshalBinary(data); err != nil || fromBinary.Peek() != 2 {
+		t.Errorf("Expected 2 on top, got %d (%v)", fromBinary.Peek(), err)
+	}
+}
+
+func TestSyncStackZeroValueEncoding(t *testing.T) {
+	var zero SyncStack[int]
+	data, err := json.Marshal(&zero)
+	if err != nil || string(data) != "[]" {
+		t.Errorf("Expected [], got %s (%v)", data, err)
+	}
+	data, err = zero.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var fromBinary SyncStack[int]
+	if err := fromBinary.UnmarshalBinary(data); err != nil || !fromBinary.IsEmpty() {
+		t.Errorf("Expected empty stack, got size %d (%v)", fromBinary.Size(), err)
+	}
+}
+
+func FuzzStackRoundTrip(f *testing.F) {
+	f.Add("")
+	f.Add("abc")
+	f.Add("line\nbreak\x00nul \"quoted\" ünïcode")
+
+	f.Fuzz(func(t *testing.T, data string) {
+		// Every suffix starting at a rune boundary becomes an element, so elements share escapes and invalid UTF-8.
+		var elements []string
+		for i := range data {
+			elements = append(elements, data[i:])
+		}
+		s := New(elements)
+
+		encoded, err := json.Marshal(s)
+		if err != nil {
+			t.Fatalf("MarshalJSON: %v", err)
+		}
+		fromJSON := New[string](nil)
+		if err := json.Unmarshal(encoded, fromJSON); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", encoded, err)
+		}
+		// JSON replaces invalid UTF-8 with U+FFFD, so contents only survive exactly for valid input.
+		if fromJSON.Size() != s.Size() {
+			t.Errorf("JSON round trip: expected size %d, got %d", s.Size(), fromJSON.Size())
+		} else if utf8.ValidString(data) && !reflect.DeepEqual(fromJSON.ToSlice(), s.ToSlice()) {
+			t.Errorf("JSON round trip: expected %q, got %q", s.ToSlice(), fromJSON.ToSlice())
+		}
+
+		encoded, err = s.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary: %v", err)
+		}
+		fromBinary := New[string](nil)
+		if err := fromBinary.UnmarshalBinary(encoded); err != nil {
+			t.Fatalf("UnmarshalBinary: %v", err)
+		}
+		if !reflect.DeepEqual(fromBinary.ToSlice(), s.ToSlice()) {
+			t.Errorf("Binary round trip: expected %q, got %q", s.ToSlice(), fromBinary.ToSlice())
+		}
+
+		// Arbitrary input must never panic.
+		junk := New[string](nil)
+		_ = junk.UnmarshalJSON([]byte(data))
+		_ = junk.UnmarshalBinary([]byte(data))
+	})
+}
@@ -0,0 +1,151 @@
+package stack
+
+import (
+	"iter"
+	"sync/atomic"
+
+	"github.com/thefrost13/gollections/node"
+)
+
+// LockFreeStack is a LIFO stack that is safe for concurrent use without locks.
+// It is a Treiber stack: Push and TryPop replace the top node with a single compare-and-swap,
+// retrying if another goroutine changed the top in between, so no goroutine ever blocks another.
+//
+// Every Push allocates a new node and a node is never modified once published. Because the garbage
+// collector does not reuse a node's memory while any goroutine still holds a pointer to it, a CAS that
+// succeeds always sees the node it read, which rules out the ABA problem without tagged pointers.
+// The zero value is an empty stack ready to use.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type LockFreeStack[T any] struct {
+	top  atomic.Pointer[node.LinkedNode[T]] // the most recently pushed node, nil if empty
+	size atomic.Int64                       // number of elements, updated after each successful CAS
+}
+
+// NewLockFree creates and returns a new empty LockFreeStack.
+// Time complexity: O(1).
+//
+// Returns:
+//   - a new empty LockFreeStack
+//
+// Example:
+//
+//	s := NewLockFree[int]()
+//	go s.Push(1)
+//	go s.Push(2)
+func NewLockFree[T any]() *LockFreeStack[T] {
+	return &LockFreeStack[T]{}
+}
+
+// Push adds an element to the top of the stack.
+// Time complexity: O(1), retried while other goroutines change the top concurrently.
+//
+// Parameters:
+//   - value: the element to add to the stack
+func (s *LockFreeStack[T]) Push(value T) {
+	n := &node.LinkedNode[T]{Value: value}
+	for {
+		n.Next = s.top.Load()
+		if s.top.CompareAndSwap(n.Next, n) {
+			s.size.Add(1)
+			return
+		}
+	}
+}
+
+// TryPop removes and returns the top element from the stack.
+// Time complexity: O(1), retried while other goroutines change the top concurrently.
+//
+// Returns:
+//   - value: the top element of the stack, or zero value if stack is empty
+//   - ok: true if an element was removed, false if the stack was empty
+//
+// Example:
+//
+//	for value, ok := s.TryPop(); ok; value, ok = s.TryPop() {
+//	    process(value)
+//	}
+func (s *LockFreeStack[T]) TryPop() (T, bool) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var zero T
+			return zero, false
+		}
+		if s.top.CompareAndSwap(top, top.Next) {
+			s.size.Add(-1)
+			return top.Value, true
+		}
+	}
+}
+
+// TryPeek returns the top element of the stack without removing it.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the top element of the stack, or zero value if stack is empty
+//   - ok: true if the stack was not empty, false otherwise
+func (s *LockFreeStack[T]) TryPeek() (T, bool) {
+	if top := s.top.Load(); top != nil {
+		return top.Value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// PopAll atomically removes every element from the stack and returns them in LIFO order.
+// Time complexity: O(n) where n is the number of elements removed.
+//
+// Returns:
+//   - the removed elements with the former top first, or nil if the stack was empty
+//
+// Example:
+//
+//	batch := s.PopAll()  // drain everything pushed so far in one step
+func (s *LockFreeStack[T]) PopAll() []T {
+	top := s.top.Swap(nil)
+	var values []T
+	for current := top; current != nil; current = current.Next {
+		values = append(values, current.Value)
+	}
+	s.size.Add(-int64(len(values)))
+	return values
+}
+
+// Size returns the number of elements in the stack.
+// Under concurrent modification the count is approximate, since it is updated just after each change.
+// Time complexity: O(1).
+func (s *LockFreeStack[T]) Size() int {
+	return int(max(s.size.Load(), 0))
+}
+
+// IsEmpty returns true if the stack contains no elements.
+// Time complexity: O(1).
+func (s *LockFreeStack[T]) IsEmpty() bool {
+	return s.top.Load() == nil
+}
+
+// ToSlice returns a slice containing the elements of the stack in LIFO order, as of a single instant.
+// Time complexity: O(n) where n is the number of elements.
+func (s *LockFreeStack[T]) ToSlice() []T {
+	slice := make([]T, 0, s.Size())
+	for v := range s.All() {
+		slice = append(slice, v)
+	}
+	return slice
+}
+
+// All returns an iterator over the elements of the stack in LIFO order, as of the start of the iteration.
+// Nodes are immutable, so the stack may be modified concurrently or by the loop body
+// without affecting the elements yielded.
+// Time complexity: O(n) for a full iteration.
+func (s *LockFreeStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := s.top.Load(); current != nil; current = current.Next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
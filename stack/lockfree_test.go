@@ -0,0 +1,179 @@
+package stack
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestLockFreeStackOperations(t *testing.T) {
+	var s LockFreeStack[int] // the zero value is ready to use
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Error("Expected zero value to be an empty stack")
+	}
+	if _, ok := s.TryPop(); ok {
+		t.Error("Expected TryPop on empty stack to fail")
+	}
+	if _, ok := s.TryPeek(); ok {
+		t.Error("Expected TryPeek on empty stack to fail")
+	}
+
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	if v, ok := s.TryPeek(); !ok || v != 3 {
+		t.Errorf("Expected (3, true), got (%d, %t)", v, ok)
+	}
+	if s.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", s.Size())
+	}
+	if !reflect.DeepEqual(s.ToSlice(), []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", s.ToSlice())
+	}
+	if v, ok := s.TryPop(); !ok || v != 3 {
+		t.Errorf("Expected (3, true), got (%d, %t)", v, ok)
+	}
+
+	s.Push(4)
+	if got := s.PopAll(); !reflect.DeepEqual(got, []int{4, 2, 1}) {
+		t.Errorf("Expected [4 2 1], got %v", got)
+	}
+	if !s.IsEmpty() || s.Size() != 0 || s.PopAll() != nil {
+		t.Error("Expected stack to be empty after PopAll")
+	}
+}
+
+func TestLockFreeStackIterationSnapshot(t *testing.T) {
+	s := NewLockFree[int]()
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	var seen []int
+	for v := range s.All() {
+		seen = append(seen, v)
+		s.TryPop()
+		s.Push(v * 10)
+	}
+	if !reflect.DeepEqual(seen, []int{3, 2, 1}) {
+		t.Errorf("Expected the iteration to see [3 2 1], got %v", seen)
+	}
+	for range s.All() {
+		break
+	}
+}
+
+func TestLockFreeStackConcurrent(t *testing.T) {
+	const goroutines = 16
+	const perGoroutine = 2000
+
+	s := NewLockFree[int]()
+	popped := make([][]int, goroutines)
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				s.Push(g*perGoroutine + i)
+				if i%2 == 1 {
+					if v, ok := s.TryPop(); ok {
+						popped[g] = append(popped[g], v)
+					}
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	all := s.PopAll()
+	for _, values := range popped {
+		all = append(all, values...)
+	}
+	slices.Sort(all)
+	if len(all) != goroutines*perGoroutine {
+		t.Fatalf("Expected %d values, got %d", goroutines*perGoroutine, len(all))
+	}
+	for i, v := range all {
+		if v != i {
+			t.Fatalf("Expected every value exactly once, got %d at position %d", v, i)
+		}
+	}
+	if s.Size() != 0 {
+		t.Errorf("Expected size 0, got %d", s.Size())
+	}
+}
+
+// Benchmark tests
+func BenchmarkLockFreeStackPushPop(b *testing.B) {
+	s := NewLockFree[int]()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		s.Push(i)
+		s.TryPop()
+	}
+}
+
+// mutexStack is the baseline for the contention benchmarks: a Stack behind a plain sync.Mutex.
+type mutexStack[T any] struct {
+	mu    sync.Mutex
+	stack Stack[T]
+}
+
+func (s *mutexStack[T]) Push(value T) {
+	s.mu.Lock()
+	s.stack.Push(value)
+	s.mu.Unlock()
+}
+
+func (s *mutexStack[T]) TryPop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.TryPop()
+}
+
+// concurrentStack is the surface shared by the stacks compared under contention.
+type concurrentStack interface {
+	Push(value int)
+	TryPop() (int, bool)
+}
+
+// BenchmarkStackContention runs b.N push/pop pairs split across 1 to 64 goroutines
+// against LockFreeStack, a Stack behind a sync.Mutex, and SyncStack.
+func BenchmarkStackContention(b *testing.B) {
+	implementations := []struct {
+		name string
+		new  func() concurrentStack
+	}{
+		{"LockFree", func() concurrentStack { return NewLockFree[int]() }},
+		{"Mutex", func() concurrentStack { return &mutexStack[int]{} }},
+		{"SyncStack", func() concurrentStack { return NewSync[int](nil) }},
+	}
+
+	for _, goroutines := range []int{1, 2, 4, 8, 16, 32, 64} {
+		for _, impl := range implementations {
+			b.Run(fmt.Sprintf("%s/goroutines=%d", impl.name, goroutines), func(b *testing.B) {
+				s := impl.new()
+				var wg sync.WaitGroup
+				b.ResetTimer()
+				for g := 0; g < goroutines; g++ {
+					ops := b.N / goroutines
+					if g < b.N%goroutines {
+						ops++
+					}
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						for i := 0; i < ops; i++ {
+							s.Push(i)
+							s.TryPop()
+						}
+					}()
+				}
+				wg.Wait()
+			})
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package stack
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+
+	"github.com/thefrost13/gollections/errs"
+)
+
+// minMaxItem is an element of a MinMaxStack together with the minimum and maximum
+// of the stack from the bottom up to and including the element.
+type minMaxItem[T any] struct {
+	value T // the pushed element
+	min   T // the smallest element at or below this one
+	max   T // the largest element at or below this one
+}
+
+// MinMaxStack is a LIFO stack that also reports its smallest and largest elements in O(1) time.
+// Each entry records the minimum and maximum of the stack beneath it, so popping an element
+// restores the previous extremes without any search.
+// Elements that compare equal are interchangeable: Min and Max report the deepest of them.
+// A MinMaxStack must be created with NewMinMax or NewMinMaxFunc.
+//
+// Type parameters:
+//   - T: the element type, ordered by the stack's compare function
+type MinMaxStack[T any] struct {
+	items   Stack[minMaxItem[T]] // the elements with their running extremes, top first
+	compare func(a, b T) int     // the ordering used for Min and Max
+}
+
+// NewMinMax creates and returns a new MinMaxStack ordered with cmp.Compare,
+// initialized with the elements from the given slice.
+// Elements are pushed in the order they appear in the slice, so the last element becomes the top.
+// Time complexity: O(n) where n is the length of the slice.
+//
+// Parameters:
+//   - sli: slice of elements to initialize the stack with, can be nil
+//
+// Returns:
+//   - a new MinMaxStack containing the elements from the slice
+//
+// Example:
+//
+//	s := NewMinMax([]int{3, 1, 4})
+//	s.Min()  // 1, true
+//	s.Max()  // 4, true
+func NewMinMax[T cmp.Ordered](sli []T) *MinMaxStack[T] {
+	return NewMinMaxFunc(cmp.Compare[T], sli)
+}
+
+// NewMinMaxFunc creates and returns a new MinMaxStack ordered with the given compare function,
+// initialized with the elements from the given slice.
+// Time complexity: O(n) where n is the length of the slice.
+//
+// Parameters:
+//   - compare: the function used to order elements, returning a negative number, zero or a positive number
+//   - sli: slice of elements to initialize the stack with, can be nil
+//
+// Returns:
+//   - a new MinMaxStack containing the elements from the slice
+//
+// Example:
+//
+//	byDeadline := NewMinMaxFunc(func(a, b Task) int {
+//	    return a.Deadline.Compare(b.Deadline)
+//	}, nil)
+func NewMinMaxFunc[T any](compare func(a, b T) int, sli []T) *MinMaxStack[T] {
+	s := &MinMaxStack[T]{compare: compare}
+	for _, v := range sli {
+		s.Push(v)
+	}
+	return s
+}
+
+// Push adds an element to the top of the stack and updates the minimum and maximum.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - value: the element to add to the stack
+func (s *MinMaxStack[T]) Push(value T) {
+	item := minMaxItem[T]{value: value, min: value, max: value}
+	if below, ok := s.items.TryPeek(); ok {
+		if s.compare(below.min, value) <= 0 {
+			item.min = below.min
+		}
+		if s.compare(below.max, value) >= 0 {
+			item.max = below.max
+		}
+	}
+	s.items.Push(item)
+}
+
+// Pop removes and returns the top element from the stack.
+// If the stack is empty, returns the zero value of type T.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the top element of the stack, or zero value if stack is empty
+func (s *MinMaxStack[T]) Pop() T {
+	return s.items.Pop().value
+}
+
+// Peek returns the top element of the stack without removing it.
+// If the stack is empty, returns the zero value of type T.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the top element of the stack, or zero value if stack is empty
+func (s *MinMaxStack[T]) Peek() T {
+	return s.items.Peek().value
+}
+
+// TryPop removes and returns the top element from the stack, reporting whether the stack was non-empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the top element of the stack, or zero value if stack is empty
+//   - ok: true if an element was removed, false if the stack was empty
+func (s *MinMaxStack[T]) TryPop() (T, bool) {
+	item, ok := s.items.TryPop()
+	return item.value, ok
+}
+
+// TryPeek returns the top element of the stack without removing it, reporting whether the stack was non-empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the top element of the stack, or zero value if stack is empty
+//   - ok: true if the stack is not empty, false otherwise
+func (s *MinMaxStack[T]) TryPeek() (T, bool) {
+	item, ok := s.items.TryPeek()
+	return item.value, ok
+}
+
+// MustPop removes and returns the top element from the stack.
+// It panics with an error wrapping errs.ErrEmpty if the stack is empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the top element of the stack
+func (s *MinMaxStack[T]) MustPop() T {
+	value, ok := s.TryPop()
+	if !ok {
+		panic(fmt.Errorf("stack: Pop: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// MustPeek returns the top element of the stack without removing it.
+// It panics with an error wrapping errs.ErrEmpty if the stack is empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the top element of the stack
+func (s *MinMaxStack[T]) MustPeek() T {
+	value, ok := s.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("stack: Peek: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// Min returns the smallest element in the stack.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the smallest element and true, or the zero value and false if the stack is empty
+//
+// Example:
+//
+//	s := NewMinMax([]int{5, 2, 8})
+//	s.Min()  // 2, true
+//	s.Pop()
+//	s.Pop()
+//	s.Min()  // 5, true
+func (s *MinMaxStack[T]) Min() (T, bool) {
+	item, ok := s.items.TryPeek()
+	return item.min, ok
+}
+
+// Max returns the largest element in the stack.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the largest element and true, or the zero value and false if the stack is empty
+func (s *MinMaxStack[T]) Max() (T, bool) {
+	item, ok := s.items.TryPeek()
+	return item.max, ok
+}
+
+// Size returns the number of elements in the stack.
+// Time complexity: O(1).
+func (s *MinMaxStack[T]) Size() int {
+	return s.items.Size()
+}
+
+// IsEmpty returns true if the stack contains no elements.
+// Time complexity: O(1).
+func (s *MinMaxStack[T]) IsEmpty() bool {
+	return s.items.IsEmpty()
+}
+
+// ToSlice returns a slice containing all elements in the stack in LIFO order.
+// The first element in the slice is the top of the stack.
+// Time complexity: O(n) where n is the number of elements.
+func (s *MinMaxStack[T]) ToSlice() []T {
+	slice := make([]T, 0, s.items.Size())
+	for v := range s.All() {
+		slice = append(slice, v)
+	}
+	return slice
+}
+
+// All returns an iterator over the elements of the stack in LIFO order.
+// The stack must not be modified during iteration.
+// Time complexity: O(n) for a full iteration.
+func (s *MinMaxStack[T]) All() iter.Seq[T] {
+	return values(s.items.All())
+}
+
+// Backward returns an iterator over the elements of the stack from bottom to top.
+// The stack must not be modified during iteration.
+// Time complexity: O(n) time and O(n) space.
+func (s *MinMaxStack[T]) Backward() iter.Seq[T] {
+	return values(s.items.Backward())
+}
+
+// Clear removes all elements from the stack, making it empty.
+// Time complexity: O(1).
+func (s *MinMaxStack[T]) Clear() {
+	s.items.Clear()
+}
+
+// values maps a sequence of items to the elements they hold.
+func values[T any](items iter.Seq[minMaxItem[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range items {
+			if !yield(item.value) {
+				return
+			}
+		}
+	}
+}
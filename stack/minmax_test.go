@@ -0,0 +1,210 @@
+package stack
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/thefrost13/gollections/errs"
+)
+
+func TestNewMinMax(t *testing.T) {
+	t.Run("NewMinMax with nil slice", func(t *testing.T) {
+		s := NewMinMax[int](nil)
+		if !s.IsEmpty() || s.Size() != 0 {
+			t.Errorf("Expected empty stack, got size %d", s.Size())
+		}
+		if _, ok := s.Min(); ok {
+			t.Error("Expected Min on empty stack to fail")
+		}
+		if _, ok := s.Max(); ok {
+			t.Error("Expected Max on empty stack to fail")
+		}
+	})
+
+	t.Run("NewMinMax with elements", func(t *testing.T) {
+		s := NewMinMax([]int{3, 1, 4, 1, 5})
+		if s.Peek() != 5 {
+			t.Errorf("Expected top 5, got %d", s.Peek())
+		}
+		if minimum, _ := s.Min(); minimum != 1 {
+			t.Errorf("Expected min 1, got %d", minimum)
+		}
+		if maximum, _ := s.Max(); maximum != 5 {
+			t.Errorf("Expected max 5, got %d", maximum)
+		}
+		if !reflect.DeepEqual(s.ToSlice(), []int{5, 1, 4, 1, 3}) {
+			t.Errorf("Expected [5 1 4 1 3], got %v", s.ToSlice())
+		}
+	})
+
+	t.Run("NewMinMaxFunc with custom order", func(t *testing.T) {
+		s := NewMinMaxFunc(func(a, b string) int { return len(a) - len(b) }, []string{"ccc", "a", "bb"})
+		if minimum, _ := s.Min(); minimum != "a" {
+			t.Errorf("Expected min a, got %s", minimum)
+		}
+		if maximum, _ := s.Max(); maximum != "ccc" {
+			t.Errorf("Expected max ccc, got %s", maximum)
+		}
+	})
+}
+
+func TestMinMaxStackOperations(t *testing.T) {
+	t.Run("Extremes follow pushes and pops", func(t *testing.T) {
+		s := NewMinMax[int](nil)
+		steps := []struct {
+			push     int
+			min, max int
+		}{
+			{5, 5, 5},
+			{7, 5, 7},
+			{2, 2, 7},
+			{9, 2, 9},
+			{3, 2, 9},
+		}
+		for _, step := range steps {
+			s.Push(step.push)
+			minimum, _ := s.Min()
+			maximum, _ := s.Max()
+			if minimum != step.min || maximum != step.max {
+				t.Errorf("After Push(%d): expected (%d, %d), got (%d, %d)", step.push, step.min, step.max, minimum, maximum)
+			}
+		}
+		for i := len(steps) - 1; i > 0; i-- {
+			if popped := s.Pop(); popped != steps[i].push {
+				t.Errorf("Expected Pop %d, got %d", steps[i].push, popped)
+			}
+			minimum, _ := s.Min()
+			maximum, _ := s.Max()
+			if minimum != steps[i-1].min || maximum != steps[i-1].max {
+				t.Errorf("After Pop: expected (%d, %d), got (%d, %d)", steps[i-1].min, steps[i-1].max, minimum, maximum)
+			}
+		}
+	})
+
+	t.Run("Ties report the deepest element", func(t *testing.T) {
+		type task struct {
+			name     string
+			priority int
+		}
+		s := NewMinMaxFunc(func(a, b task) int { return a.priority - b.priority }, []task{{"first", 1}, {"second", 1}})
+		if minimum, _ := s.Min(); minimum.name != "first" {
+			t.Errorf("Expected min first, got %s", minimum.name)
+		}
+		if maximum, _ := s.Max(); maximum.name != "first" {
+			t.Errorf("Expected max first, got %s", maximum.name)
+		}
+	})
+
+	t.Run("Empty stack", func(t *testing.T) {
+		s := NewMinMax[int](nil)
+		if s.Pop() != 0 || s.Peek() != 0 {
+			t.Error("Expected zero values from empty stack")
+		}
+		if _, ok := s.TryPop(); ok {
+			t.Error("Expected TryPop on empty stack to fail")
+		}
+		if _, ok := s.TryPeek(); ok {
+			t.Error("Expected TryPeek on empty stack to fail")
+		}
+		for name, fn := range map[string]func(){"MustPop": func() { s.MustPop() }, "MustPeek": func() { s.MustPeek() }} {
+			if err := recoverError(fn); !errors.Is(err, errs.ErrEmpty) {
+				t.Errorf("Expected %s to panic with ErrEmpty, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("Try and Must on non-empty stack", func(t *testing.T) {
+		s := NewMinMax([]int{1, 2, 3})
+		if v, ok := s.TryPeek(); !ok || v != 3 {
+			t.Errorf("Expected (3, true), got (%d, %t)", v, ok)
+		}
+		if v, ok := s.TryPop(); !ok || v != 3 {
+			t.Errorf("Expected (3, true), got (%d, %t)", v, ok)
+		}
+		if s.MustPeek() != 2 || s.MustPop() != 2 {
+			t.Error("Expected MustPeek and MustPop to return 2")
+		}
+		if maximum, _ := s.Max(); maximum != 1 {
+			t.Errorf("Expected max 1, got %d", maximum)
+		}
+	})
+
+	t.Run("Iterators and Clear", func(t *testing.T) {
+		s := NewMinMax([]int{1, 2, 3})
+		if got := slices.Collect(s.All()); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+			t.Errorf("Expected [3 2 1], got %v", got)
+		}
+		if got := slices.Collect(s.Backward()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", got)
+		}
+		for range s.All() {
+			break
+		}
+		s.Clear()
+		if !s.IsEmpty() {
+			t.Error("Expected stack to be empty after Clear")
+		}
+		if _, ok := s.Min(); ok {
+			t.Error("Expected Min after Clear to fail")
+		}
+	})
+}
+
+func TestMinMaxStackRandomized(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	s := NewMinMax[int](nil)
+	var model []int
+
+	for i := 0; i < 2000; i++ {
+		if len(model) > 0 && rng.Intn(3) == 0 {
+			if popped := s.Pop(); popped != model[len(model)-1] {
+				t.Fatalf("Expected Pop %d, got %d", model[len(model)-1], popped)
+			}
+			model = model[:len(model)-1]
+		} else {
+			v := rng.Intn(1000)
+			s.Push(v)
+			model = append(model, v)
+		}
+
+		minimum, okMin := s.Min()
+		maximum, okMax := s.Max()
+		if len(model) == 0 {
+			if okMin || okMax {
+				t.Fatal("Expected Min and Max on empty stack to fail")
+			}
+			continue
+		}
+		if minimum != slices.Min(model) || maximum != slices.Max(model) {
+			t.Fatalf("Expected (%d, %d), got (%d, %d)", slices.Min(model), slices.Max(model), minimum, maximum)
+		}
+	}
+}
+
+// Benchmark tests
+func BenchmarkMinMaxStackPushPop(b *testing.B) {
+	s := NewMinMax[int](nil)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		s.Push(i)
+		if i%2 == 1 {
+			s.Pop()
+		}
+	}
+}
+
+func BenchmarkMinMaxStackMin(b *testing.B) {
+	s := NewMinMax[int](nil)
+	for i := 0; i < 1000; i++ {
+		s.Push(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		s.Min()
+	}
+}
@@ -2,7 +2,13 @@
 // using a linked list for efficient push and pop operations.
 package stack
 
-import "github.com/thefrost13/gollections/node"
+import (
+	"fmt"
+	"iter"
+
+	"github.com/thefrost13/gollections/errs"
+	"github.com/thefrost13/gollections/node"
+)
 
 // Stack is a generic LIFO (Last In, First Out) data structure implemented using a linked list.
 // It provides O(1) push and pop operations and maintains elements in reverse order of insertion.
@@ -44,6 +50,27 @@ func New[T any](sli []T) *Stack[T] {
 	return s
 }
 
+// FromSeq creates and returns a new Stack containing the elements yielded by seq.
+// Elements are pushed in the order they are yielded, so the last element becomes the top.
+// Time complexity: O(n) where n is the number of elements yielded.
+//
+// Parameters:
+//   - seq: the sequence of elements to initialize the stack with
+//
+// Returns:
+//   - a new Stack containing the elements from the sequence
+//
+// Example:
+//
+//	stack := FromSeq(slices.Values([]int{1, 2, 3}))  // Creates stack with 3 on top
+func FromSeq[T any](seq iter.Seq[T]) *Stack[T] {
+	s := &Stack[T]{}
+	for v := range seq {
+		s.Push(v)
+	}
+	return s
+}
+
 // Push adds an element to the top of the stack.
 // The element becomes the new top and will be the first to be popped.
 // Time complexity: O(1).
@@ -113,6 +140,85 @@ func (s *Stack[T]) Peek() T {
 	return value
 }
 
+// TryPop removes and returns the top element from the stack.
+// Unlike Pop, it reports whether an element was actually removed, which distinguishes
+// an empty stack from a stored zero value.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the top element of the stack, or zero value if stack is empty
+//   - ok: true if an element was removed, false if the stack was empty
+//
+// Example:
+//
+//	if value, ok := stack.TryPop(); ok {
+//	    fmt.Printf("Popped: %v\n", value)
+//	}
+func (s *Stack[T]) TryPop() (T, bool) {
+	if s.top == nil {
+		var zero T
+		return zero, false
+	}
+	return s.Pop(), true
+}
+
+// TryPeek returns the top element of the stack without removing it.
+// Unlike Peek, it reports whether the stack had an element.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the top element of the stack, or zero value if stack is empty
+//   - ok: true if the stack is not empty, false otherwise
+//
+// Example:
+//
+//	if top, ok := stack.TryPeek(); ok {
+//	    fmt.Printf("Top element: %v\n", top)
+//	}
+func (s *Stack[T]) TryPeek() (T, bool) {
+	if s.top == nil {
+		var zero T
+		return zero, false
+	}
+	return s.top.Value, true
+}
+
+// MustPop removes and returns the top element from the stack.
+// It panics with an error wrapping errs.ErrEmpty if the stack is empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the top element of the stack
+//
+// Example:
+//
+//	value := stack.MustPop()  // Panics if stack is empty
+func (s *Stack[T]) MustPop() T {
+	value, ok := s.TryPop()
+	if !ok {
+		panic(fmt.Errorf("stack: Pop: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
+// MustPeek returns the top element of the stack without removing it.
+// It panics with an error wrapping errs.ErrEmpty if the stack is empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the top element of the stack
+//
+// Example:
+//
+//	top := stack.MustPeek()  // Panics if stack is empty
+func (s *Stack[T]) MustPeek() T {
+	value, ok := s.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("stack: Peek: %w", errs.ErrEmpty))
+	}
+	return value
+}
+
 // Size returns the number of elements in the stack.
 // Time complexity: O(1).
 //
@@ -164,6 +270,57 @@ func (s *Stack[T]) ToSlice() []T {
 	return slice
 }
 
+// All returns an iterator over the elements of the stack in LIFO order.
+// The first element yielded is the top of the stack.
+// The stack must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, with no allocation of an intermediate slice.
+//
+// Returns:
+//   - an iter.Seq yielding the stack elements from top to bottom
+//
+// Example:
+//
+//	for v := range stack.All() {
+//	    fmt.Println(v)
+//	}
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := s.top; current != nil; current = current.Next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the stack from bottom to top.
+// The first element yielded is the oldest element in the stack.
+// Because the stack is singly linked, the nodes are buffered before iteration begins.
+// The stack must not be modified during iteration.
+// Time complexity: O(n) time and O(n) space.
+//
+// Returns:
+//   - an iter.Seq yielding the stack elements from bottom to top
+//
+// Example:
+//
+//	for v := range stack.Backward() {
+//	    fmt.Println(v)  // Oldest element first
+//	}
+func (s *Stack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		nodes := make([]*node.LinkedNode[T], 0, s.size)
+		for current := s.top; current != nil; current = current.Next {
+			nodes = append(nodes, current)
+		}
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !yield(nodes[i].Value) {
+				return
+			}
+		}
+	}
+}
+
 // Clear removes all elements from the stack, making it empty.
 // Time complexity: O(1).
 //
@@ -1,8 +1,12 @@
 package stack
 
 import (
+	"errors"
 	"reflect"
+	"slices"
 	"testing"
+
+	"github.com/thefrost13/gollections/errs"
 )
 
 func TestNew(t *testing.T) {
@@ -400,6 +404,136 @@ func TestStackIntegration(t *testing.T) {
 	})
 }
 
+func TestStackFromSeq(t *testing.T) {
+	stack := FromSeq(slices.Values([]int{1, 2, 3}))
+	if stack.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", stack.Size())
+	}
+	if stack.Peek() != 3 {
+		t.Errorf("Expected top 3, got %d", stack.Peek())
+	}
+	if !reflect.DeepEqual(stack.ToSlice(), []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", stack.ToSlice())
+	}
+
+	empty := FromSeq(slices.Values([]int(nil)))
+	if !empty.IsEmpty() {
+		t.Error("Expected empty stack from empty sequence")
+	}
+}
+
+func TestStackIterators(t *testing.T) {
+	t.Run("all yields LIFO order", func(t *testing.T) {
+		stack := New([]int{1, 2, 3})
+		got := slices.Collect(stack.All())
+		if !reflect.DeepEqual(got, stack.ToSlice()) {
+			t.Errorf("Expected %v, got %v", stack.ToSlice(), got)
+		}
+	})
+
+	t.Run("backward yields insertion order", func(t *testing.T) {
+		stack := New([]int{1, 2, 3})
+		got := slices.Collect(stack.Backward())
+		expected := []int{1, 2, 3}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("empty stack", func(t *testing.T) {
+		stack := New[int](nil)
+		if got := slices.Collect(stack.All()); len(got) != 0 {
+			t.Errorf("Expected no elements, got %v", got)
+		}
+		if got := slices.Collect(stack.Backward()); len(got) != 0 {
+			t.Errorf("Expected no elements, got %v", got)
+		}
+	})
+
+	t.Run("early break", func(t *testing.T) {
+		stack := New([]int{1, 2, 3})
+		var got []int
+		for v := range stack.All() {
+			got = append(got, v)
+			break
+		}
+		for v := range stack.Backward() {
+			got = append(got, v)
+			break
+		}
+		if !reflect.DeepEqual(got, []int{3, 1}) {
+			t.Errorf("Expected [3 1], got %v", got)
+		}
+	})
+}
+
+// recoverError runs fn and returns the error it panicked with, or nil if it did not panic.
+func recoverError(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, _ = r.(error)
+		}
+	}()
+	fn()
+	return nil
+}
+
+func TestStackTryOperations(t *testing.T) {
+	t.Run("try on empty stack", func(t *testing.T) {
+		stack := New[int](nil)
+		if _, ok := stack.TryPop(); ok {
+			t.Error("Expected TryPop to report empty stack")
+		}
+		if _, ok := stack.TryPeek(); ok {
+			t.Error("Expected TryPeek to report empty stack")
+		}
+	})
+
+	t.Run("try distinguishes stored zero value", func(t *testing.T) {
+		stack := New([]int{0})
+		if value, ok := stack.TryPeek(); !ok || value != 0 {
+			t.Errorf("Expected (0, true), got (%d, %t)", value, ok)
+		}
+		if value, ok := stack.TryPop(); !ok || value != 0 {
+			t.Errorf("Expected (0, true), got (%d, %t)", value, ok)
+		}
+		if !stack.IsEmpty() {
+			t.Error("Expected stack to be empty after TryPop")
+		}
+	})
+
+	t.Run("try pop follows LIFO order", func(t *testing.T) {
+		stack := New([]string{"a", "b"})
+		first, _ := stack.TryPop()
+		second, _ := stack.TryPop()
+		if first != "b" || second != "a" {
+			t.Errorf("Expected b, a; got %s, %s", first, second)
+		}
+	})
+}
+
+func TestStackMustOperations(t *testing.T) {
+	t.Run("must on empty stack panics with ErrEmpty", func(t *testing.T) {
+		stack := New[int](nil)
+		if err := recoverError(func() { stack.MustPop() }); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected MustPop to panic with ErrEmpty, got %v", err)
+		}
+		if err := recoverError(func() { stack.MustPeek() }); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected MustPeek to panic with ErrEmpty, got %v", err)
+		}
+	})
+
+	t.Run("must on non-empty stack", func(t *testing.T) {
+		stack := New([]int{1, 2})
+		if stack.MustPeek() != 2 {
+			t.Error("Expected MustPeek to return 2")
+		}
+		if stack.MustPop() != 2 || stack.MustPop() != 1 {
+			t.Error("Expected MustPop to return 2 then 1")
+		}
+	})
+}
+
 // Benchmark tests
 func BenchmarkStackPush(b *testing.B) {
 	stack := New[int](nil)
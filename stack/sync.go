@@ -0,0 +1,252 @@
+package stack
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncStack is a Stack that is safe for concurrent use by multiple goroutines.
+// Reads take a shared lock and writes take an exclusive lock on a sync.RWMutex,
+// and compound operations such as TryPop and PopIf are performed atomically.
+//
+// Type parameters:
+//   - T: the element type, can be any type
+type SyncStack[T any] struct {
+	mu    sync.RWMutex // guards stack
+	stack *Stack[T]    // the wrapped stack
+}
+
+// NewSync creates and returns a new SyncStack wrapping the given stack.
+// The caller must not use s directly afterwards. If s is nil, an empty stack is used.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - s: the Stack to guard, can be nil
+//
+// Returns:
+//   - a new SyncStack guarding s
+//
+// Example:
+//
+//	stack := NewSync(New([]int{1, 2, 3}))
+//	go stack.Push(4)
+func NewSync[T any](s *Stack[T]) *SyncStack[T] {
+	if s == nil {
+		s = &Stack[T]{}
+	}
+	return &SyncStack[T]{stack: s}
+}
+
+// Push adds an element to the top of the stack.
+// Time complexity: O(1).
+func (s *SyncStack[T]) Push(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Push(value)
+}
+
+// Pop removes and returns the top element from the stack.
+// If the stack is empty, returns the zero value of type T.
+// Time complexity: O(1).
+func (s *SyncStack[T]) Pop() T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Pop()
+}
+
+// Peek returns the top element of the stack without removing it.
+// If the stack is empty, returns the zero value of type T.
+// Time complexity: O(1).
+func (s *SyncStack[T]) Peek() T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Peek()
+}
+
+// TryPop atomically removes and returns the top element if the stack is not empty.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the top element of the stack, or zero value if stack is empty
+//   - ok: true if an element was removed, false if the stack was empty
+func (s *SyncStack[T]) TryPop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.TryPop()
+}
+
+// TryPeek returns the top element of the stack without removing it.
+// Time complexity: O(1).
+//
+// Returns:
+//   - value: the top element of the stack, or zero value if stack is empty
+//   - ok: true if the stack is not empty, false otherwise
+func (s *SyncStack[T]) TryPeek() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.TryPeek()
+}
+
+// MustPop removes and returns the top element from the stack.
+// It panics with an error wrapping errs.ErrEmpty if the stack is empty.
+// Time complexity: O(1).
+func (s *SyncStack[T]) MustPop() T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.MustPop()
+}
+
+// MustPeek returns the top element of the stack without removing it.
+// It panics with an error wrapping errs.ErrEmpty if the stack is empty.
+// Time complexity: O(1).
+func (s *SyncStack[T]) MustPeek() T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.MustPeek()
+}
+
+// PopIf atomically removes and returns the top element if the stack is not empty
+// and the element satisfies predicate. The predicate runs while the lock is held
+// and must not call methods on the same stack.
+// Time complexity: O(1) plus the cost of predicate.
+//
+// Parameters:
+//   - predicate: reports whether the top element should be popped
+//
+// Returns:
+//   - value: the popped element, or zero value if nothing was popped
+//   - ok: true if an element was popped, false otherwise
+//
+// Example:
+//
+//	// Pop only completed tasks
+//	task, ok := stack.PopIf(func(t Task) bool { return t.Done })
+func (s *SyncStack[T]) PopIf(predicate func(T) bool) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if top, ok := s.stack.TryPeek(); !ok || !predicate(top) {
+		var zero T
+		return zero, false
+	}
+	return s.stack.TryPop()
+}
+
+// PopAll atomically removes every element and returns them in LIFO order.
+// Time complexity: O(n) where n is the number of elements.
+//
+// Returns:
+//   - a slice containing the removed elements, top first
+func (s *SyncStack[T]) PopAll() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	values := s.stack.ToSlice()
+	s.stack.Clear()
+	return values
+}
+
+// Size returns the number of elements in the stack.
+// Time complexity: O(1).
+func (s *SyncStack[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Size()
+}
+
+// IsEmpty returns true if the stack contains no elements.
+// Time complexity: O(1).
+func (s *SyncStack[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.IsEmpty()
+}
+
+// ToSlice returns a slice containing all elements in the stack in LIFO order.
+// Time complexity: O(n) where n is the number of elements.
+func (s *SyncStack[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.ToSlice()
+}
+
+// All returns an iterator over a snapshot of the stack in LIFO order.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the stack.
+// Time complexity: O(n) time and O(n) space.
+func (s *SyncStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.ToSlice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over a snapshot of the stack from bottom to top.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the stack.
+// Time complexity: O(n) time and O(n) space.
+func (s *SyncStack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		values := s.ToSlice()
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all elements from the stack, making it empty.
+// Time complexity: O(1).
+func (s *SyncStack[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Clear()
+}
+
+// MarshalJSON implements json.Marshaler using the encoding of the wrapped stack.
+// A zero-value wrapper encodes as an empty stack.
+// Time complexity: O(n).
+func (s *SyncStack[T]) MarshalJSON() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.stack == nil {
+		return New[T](nil).MarshalJSON()
+	}
+	return s.stack.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of the wrapped stack.
+// A zero-value wrapper gets a new empty stack to decode into.
+// Time complexity: O(n).
+func (s *SyncStack[T]) UnmarshalJSON(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stack == nil {
+		s.stack = New[T](nil)
+	}
+	return s.stack.UnmarshalJSON(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using the encoding of the wrapped stack.
+// A zero-value wrapper encodes as an empty stack.
+// Time complexity: O(n).
+func (s *SyncStack[T]) MarshalBinary() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.stack == nil {
+		return New[T](nil).MarshalBinary()
+	}
+	return s.stack.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the wrapped stack.
+// A zero-value wrapper gets a new empty stack to decode into.
+// Time complexity: O(n).
+func (s *SyncStack[T]) UnmarshalBinary(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stack == nil {
+		s.stack = New[T](nil)
+	}
+	return s.stack.UnmarshalBinary(data)
+}
@@ -0,0 +1,142 @@
+package stack
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/thefrost13/gollections/errs"
+)
+
+func TestNewSync(t *testing.T) {
+	t.Run("wrap nil stack", func(t *testing.T) {
+		stack := NewSync[int](nil)
+		if !stack.IsEmpty() {
+			t.Error("Expected empty stack")
+		}
+		stack.Push(1)
+		if stack.Peek() != 1 {
+			t.Error("Expected top 1")
+		}
+	})
+
+	t.Run("wrap existing stack", func(t *testing.T) {
+		stack := NewSync(New([]int{1, 2, 3}))
+		if !reflect.DeepEqual(stack.ToSlice(), []int{3, 2, 1}) {
+			t.Errorf("Expected [3 2 1], got %v", stack.ToSlice())
+		}
+	})
+}
+
+func TestSyncStackOperations(t *testing.T) {
+	stack := NewSync(New([]int{1, 2, 3}))
+
+	if value, ok := stack.TryPeek(); !ok || value != 3 {
+		t.Errorf("Expected (3, true), got (%d, %t)", value, ok)
+	}
+	if _, ok := stack.PopIf(func(v int) bool { return v > 10 }); ok {
+		t.Error("Expected PopIf to reject top element")
+	}
+	if value, ok := stack.PopIf(func(v int) bool { return v == 3 }); !ok || value != 3 {
+		t.Errorf("Expected PopIf to pop 3, got (%d, %t)", value, ok)
+	}
+	if stack.MustPeek() != 2 || stack.MustPop() != 2 {
+		t.Error("Expected Must operations to return 2")
+	}
+	if value, ok := stack.TryPop(); !ok || value != 1 {
+		t.Errorf("Expected (1, true), got (%d, %t)", value, ok)
+	}
+	if _, ok := stack.PopIf(func(int) bool { return true }); ok {
+		t.Error("Expected PopIf on empty stack to fail")
+	}
+	if err := recoverError(func() { stack.MustPop() }); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("Expected MustPop to panic with ErrEmpty, got %v", err)
+	}
+
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	if got := slices.Collect(stack.All()); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("Expected All [3 2 1], got %v", got)
+	}
+	if got := slices.Collect(stack.Backward()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Expected Backward [1 2 3], got %v", got)
+	}
+	for range stack.All() {
+		stack.Pop() // modifying during iteration must not deadlock
+	}
+	if stack.Size() != 0 {
+		t.Errorf("Expected size 0, got %d", stack.Size())
+	}
+
+	stack.Push(4)
+	stack.Push(5)
+	if got := stack.PopAll(); !reflect.DeepEqual(got, []int{5, 4}) || !stack.IsEmpty() {
+		t.Errorf("Expected PopAll to drain [5 4], got %v", got)
+	}
+	stack.Push(6)
+	stack.Clear()
+	if stack.Pop() != 0 {
+		t.Error("Expected zero value after Clear")
+	}
+}
+
+func TestSyncStackConcurrent(t *testing.T) {
+	const goroutines = 32
+	const perGoroutine = 500
+
+	stack := NewSync[int](nil)
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				stack.Push(g*perGoroutine + i)
+				stack.Peek()
+				stack.Size()
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if stack.Size() != goroutines*perGoroutine {
+		t.Fatalf("Expected size %d, got %d", goroutines*perGoroutine, stack.Size())
+	}
+
+	results := make(chan []int, goroutines)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var popped []int
+			for {
+				value, ok := stack.TryPop()
+				if !ok {
+					break
+				}
+				popped = append(popped, value)
+			}
+			results <- popped
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	var all []int
+	for popped := range results {
+		all = append(all, popped...)
+	}
+	sort.Ints(all)
+	for i, v := range all {
+		if v != i {
+			t.Fatalf("Expected every pushed value exactly once, mismatch at %d: %d", i, v)
+		}
+	}
+	if len(all) != goroutines*perGoroutine {
+		t.Errorf("Expected %d popped values, got %d", goroutines*perGoroutine, len(all))
+	}
+}
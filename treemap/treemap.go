@@ -0,0 +1,788 @@
+// Package treemap provides a sorted map implementation backed by a left-leaning red-black tree.
+// Keys are kept in order, enabling floor/ceiling lookups, range scans and rank queries
+// in O(log n) time.
+package treemap
+
+import (
+	"cmp"
+	"iter"
+)
+
+// KVPair represents a key-value pair stored in the TreeMap.
+type KVPair[K, V any] struct {
+	Key   K // the key of the pair
+	Value V // the value associated with the key
+}
+
+// node is a node of the red-black tree.
+type node[K, V any] struct {
+	key         K           // the key stored in the node
+	value       V           // the value associated with the key
+	left, right *node[K, V] // children, smaller and larger keys
+	red         bool        // color of the link from the parent to this node
+	size        int         // number of nodes in the subtree rooted here
+}
+
+// TreeMap is a generic map that keeps its keys sorted.
+// It is implemented as a left-leaning red-black tree whose nodes also track their subtree size,
+// so lookups, updates, ordered queries and rank queries all run in O(log n) time.
+// A TreeMap must be created with New or NewFunc.
+//
+// Type parameters:
+//   - K: the key type, ordered by the map's compare function
+//   - V: the value type, can be any type
+type TreeMap[K, V any] struct {
+	root    *node[K, V]      // root of the tree, nil if empty
+	compare func(a, b K) int // returns a negative number if a < b, zero if a == b, positive if a > b
+}
+
+// New creates and returns a new empty TreeMap ordering keys with cmp.Compare.
+// Time complexity: O(1).
+//
+// Returns:
+//   - a new empty TreeMap
+//
+// Example:
+//
+//	tm := New[int, string]()
+//	tm.Put(3, "three")
+//	tm.Put(1, "one")
+//	tm.Keys()  // [1 3]
+func New[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewFunc[K, V](cmp.Compare[K])
+}
+
+// NewFunc creates and returns a new empty TreeMap ordering keys with the given compare function.
+// compare must describe a strict weak ordering, returning a negative number when a < b,
+// zero when a and b are equivalent, and a positive number when a > b.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - compare: the function used to order keys
+//
+// Returns:
+//   - a new empty TreeMap
+//
+// Example:
+//
+//	byLength := NewFunc[string, int](func(a, b string) int {
+//	    return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+//	})
+func NewFunc[K, V any](compare func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{compare: compare}
+}
+
+// Put inserts or updates a key-value pair in the TreeMap.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Parameters:
+//   - key: the key to insert or update
+//   - value: the value to associate with the key
+//
+// Example:
+//
+//	tm.Put("apple", 5)
+func (tm *TreeMap[K, V]) Put(key K, value V) {
+	tm.root = tm.put(tm.root, key, value)
+	tm.root.red = false
+}
+
+// Get retrieves the value associated with the given key.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - value: the value associated with the key, or zero value if key not found
+//   - ok: true if the key exists in the map, false otherwise
+//
+// Example:
+//
+//	if value, ok := tm.Get("apple"); ok {
+//	    fmt.Println(value)
+//	}
+func (tm *TreeMap[K, V]) Get(key K) (V, bool) {
+	if x := tm.find(key); x != nil {
+		return x.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains reports whether the given key exists in the TreeMap.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - true if the key exists in the map, false otherwise
+func (tm *TreeMap[K, V]) Contains(key K) bool {
+	return tm.find(key) != nil
+}
+
+// Delete removes the key-value pair with the given key.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Parameters:
+//   - key: the key to remove
+//
+// Returns:
+//   - true if the key was present, false otherwise
+//
+// Example:
+//
+//	tm.Delete("apple")
+func (tm *TreeMap[K, V]) Delete(key K) bool {
+	if !tm.Contains(key) {
+		return false
+	}
+	if !isRed(tm.root.left) && !isRed(tm.root.right) {
+		tm.root.red = true
+	}
+	tm.root = tm.delete(tm.root, key)
+	if tm.root != nil {
+		tm.root.red = false
+	}
+	return true
+}
+
+// Min returns the entry with the smallest key.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Returns:
+//   - key: the smallest key, or zero value if the map is empty
+//   - value: its value, or zero value if the map is empty
+//   - ok: true if the map is not empty, false otherwise
+func (tm *TreeMap[K, V]) Min() (K, V, bool) {
+	if tm.root == nil {
+		return entry[K, V](nil)
+	}
+	return entry(minNode(tm.root))
+}
+
+// Max returns the entry with the largest key.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Returns:
+//   - key: the largest key, or zero value if the map is empty
+//   - value: its value, or zero value if the map is empty
+//   - ok: true if the map is not empty, false otherwise
+func (tm *TreeMap[K, V]) Max() (K, V, bool) {
+	if tm.root == nil {
+		return entry[K, V](nil)
+	}
+	return entry(maxNode(tm.root))
+}
+
+// PopMin removes and returns the entry with the smallest key.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Returns:
+//   - key: the smallest key, or zero value if the map is empty
+//   - value: its value, or zero value if the map is empty
+//   - ok: true if an entry was removed, false if the map was empty
+//
+// Example:
+//
+//	for key, value, ok := tm.PopMin(); ok; key, value, ok = tm.PopMin() {
+//	    process(key, value)  // entries in ascending key order
+//	}
+func (tm *TreeMap[K, V]) PopMin() (K, V, bool) {
+	if tm.root == nil {
+		return entry[K, V](nil)
+	}
+	key, value, _ := entry(minNode(tm.root))
+	if !isRed(tm.root.left) && !isRed(tm.root.right) {
+		tm.root.red = true
+	}
+	tm.root = deleteMin(tm.root)
+	if tm.root != nil {
+		tm.root.red = false
+	}
+	return key, value, true
+}
+
+// PopMax removes and returns the entry with the largest key.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Returns:
+//   - key: the largest key, or zero value if the map is empty
+//   - value: its value, or zero value if the map is empty
+//   - ok: true if an entry was removed, false if the map was empty
+func (tm *TreeMap[K, V]) PopMax() (K, V, bool) {
+	key, value, ok := tm.Max()
+	if ok {
+		tm.Delete(key)
+	}
+	return key, value, ok
+}
+
+// Floor returns the entry with the largest key less than or equal to the given key.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Parameters:
+//   - key: the key to search from
+//
+// Returns:
+//   - the floor key, its value and true, or zero values and false if no such key exists
+//
+// Example:
+//
+//	tm := New[int, string]()
+//	tm.Put(10, "ten")
+//	tm.Put(20, "twenty")
+//	tm.Floor(15)  // 10, "ten", true
+func (tm *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	var best *node[K, V]
+	for x := tm.root; x != nil; {
+		c := tm.compare(key, x.key)
+		if c == 0 {
+			return entry(x)
+		}
+		if c < 0 {
+			x = x.left
+		} else {
+			best, x = x, x.right
+		}
+	}
+	return entry(best)
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to the given key.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Parameters:
+//   - key: the key to search from
+//
+// Returns:
+//   - the ceiling key, its value and true, or zero values and false if no such key exists
+func (tm *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *node[K, V]
+	for x := tm.root; x != nil; {
+		c := tm.compare(key, x.key)
+		if c == 0 {
+			return entry(x)
+		}
+		if c > 0 {
+			x = x.right
+		} else {
+			best, x = x, x.left
+		}
+	}
+	return entry(best)
+}
+
+// Lower returns the entry with the largest key strictly less than the given key.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Parameters:
+//   - key: the key to search from
+//
+// Returns:
+//   - the lower key, its value and true, or zero values and false if no such key exists
+func (tm *TreeMap[K, V]) Lower(key K) (K, V, bool) {
+	var best *node[K, V]
+	for x := tm.root; x != nil; {
+		if tm.compare(key, x.key) <= 0 {
+			x = x.left
+		} else {
+			best, x = x, x.right
+		}
+	}
+	return entry(best)
+}
+
+// Higher returns the entry with the smallest key strictly greater than the given key.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Parameters:
+//   - key: the key to search from
+//
+// Returns:
+//   - the higher key, its value and true, or zero values and false if no such key exists
+func (tm *TreeMap[K, V]) Higher(key K) (K, V, bool) {
+	var best *node[K, V]
+	for x := tm.root; x != nil; {
+		if tm.compare(key, x.key) >= 0 {
+			x = x.right
+		} else {
+			best, x = x, x.left
+		}
+	}
+	return entry(best)
+}
+
+// Rank returns the number of keys in the map that are strictly less than the given key.
+// If key is present, this is its zero-based position in ascending order.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Parameters:
+//   - key: the key to rank, need not be present
+//
+// Returns:
+//   - the number of smaller keys
+//
+// Example:
+//
+//	tm := New[string, int]()
+//	tm.Put("a", 1)
+//	tm.Put("c", 3)
+//	tm.Rank("c")  // 1
+//	tm.Rank("b")  // 1
+func (tm *TreeMap[K, V]) Rank(key K) int {
+	rank := 0
+	for x := tm.root; x != nil; {
+		c := tm.compare(key, x.key)
+		switch {
+		case c < 0:
+			x = x.left
+		case c > 0:
+			rank += size(x.left) + 1
+			x = x.right
+		default:
+			return rank + size(x.left)
+		}
+	}
+	return rank
+}
+
+// Select returns the entry whose key has the given zero-based rank in ascending order.
+// Time complexity: O(log n) where n is the number of entries.
+//
+// Parameters:
+//   - rank: the position of the entry, in the range [0, Size())
+//
+// Returns:
+//   - the key and value at that position and true, or zero values and false if rank is out of range
+//
+// Example:
+//
+//	median, _, _ := tm.Select(tm.Size() / 2)
+func (tm *TreeMap[K, V]) Select(rank int) (K, V, bool) {
+	if rank < 0 || rank >= tm.Size() {
+		return entry[K, V](nil)
+	}
+	x := tm.root
+	for {
+		leftSize := size(x.left)
+		switch {
+		case rank < leftSize:
+			x = x.left
+		case rank > leftSize:
+			rank -= leftSize + 1
+			x = x.right
+		default:
+			return entry(x)
+		}
+	}
+}
+
+// Size returns the number of entries in the TreeMap.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the number of entries
+func (tm *TreeMap[K, V]) Size() int {
+	return size(tm.root)
+}
+
+// IsEmpty returns true if the TreeMap contains no entries.
+// Time complexity: O(1).
+//
+// Returns:
+//   - true if the map is empty, false otherwise
+func (tm *TreeMap[K, V]) IsEmpty() bool {
+	return tm.root == nil
+}
+
+// Clear removes all entries from the TreeMap.
+// Time complexity: O(1).
+func (tm *TreeMap[K, V]) Clear() {
+	tm.root = nil
+}
+
+// Keys returns all keys in ascending order.
+// Time complexity: O(n) where n is the number of entries.
+//
+// Returns:
+//   - a slice of keys in ascending order
+func (tm *TreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, tm.Size())
+	for key := range tm.All() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns all values in ascending order of their keys.
+// Time complexity: O(n) where n is the number of entries.
+//
+// Returns:
+//   - a slice of values ordered by key
+func (tm *TreeMap[K, V]) Values() []V {
+	values := make([]V, 0, tm.Size())
+	for _, value := range tm.All() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// ToSlice returns all key-value pairs in ascending key order.
+// Time complexity: O(n) where n is the number of entries.
+//
+// Returns:
+//   - a slice of key-value pairs in ascending key order
+func (tm *TreeMap[K, V]) ToSlice() []KVPair[K, V] {
+	pairs := make([]KVPair[K, V], 0, tm.Size())
+	for key, value := range tm.All() {
+		pairs = append(pairs, KVPair[K, V]{Key: key, Value: value})
+	}
+	return pairs
+}
+
+// All returns an iterator over the entries in ascending key order.
+// The map must not be modified during iteration.
+// Time complexity: O(n) for a full iteration.
+//
+// Returns:
+//   - an iter.Seq2 yielding each key and its value in ascending key order
+//
+// Example:
+//
+//	for key, value := range tm.All() {
+//	    fmt.Printf("%v: %v\n", key, value)
+//	}
+func (tm *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		ascend(tm.root, yield)
+	}
+}
+
+// Backward returns an iterator over the entries in descending key order.
+// The map must not be modified during iteration.
+// Time complexity: O(n) for a full iteration.
+//
+// Returns:
+//   - an iter.Seq2 yielding each key and its value in descending key order
+func (tm *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		descend(tm.root, yield)
+	}
+}
+
+// Range returns an iterator over the entries whose keys lie in the half-open interval [from, to),
+// in ascending key order. If from is not less than to, the iterator yields nothing.
+// The map must not be modified during iteration.
+// Time complexity: O(log n + k) where k is the number of entries yielded.
+//
+// Parameters:
+//   - from: the inclusive lower bound
+//   - to: the exclusive upper bound
+//
+// Returns:
+//   - an iter.Seq2 yielding each key in [from, to) and its value
+//
+// Example:
+//
+//	for ts, event := range events.Range(start, end) {
+//	    fmt.Println(ts, event)
+//	}
+func (tm *TreeMap[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if tm.compare(from, to) < 0 {
+			tm.ascendRange(tm.root, from, to, yield)
+		}
+	}
+}
+
+// AscendFrom returns an iterator over the entries whose keys are greater than or equal to key,
+// in ascending key order. It seeks to the first such entry once instead of testing every key.
+// The map must not be modified during iteration.
+// Time complexity: O(log n + k) where k is the number of entries yielded.
+//
+// Parameters:
+//   - key: the inclusive lower bound
+//
+// Returns:
+//   - an iter.Seq2 yielding each key >= key and its value in ascending key order
+//
+// Example:
+//
+//	for ts, event := range events.AscendFrom(since) {
+//	    if ts.After(deadline) {
+//	        break
+//	    }
+//	    fmt.Println(ts, event)
+//	}
+func (tm *TreeMap[K, V]) AscendFrom(key K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		tm.ascendFrom(tm.root, key, yield)
+	}
+}
+
+// DescendBelow returns an iterator over the entries whose keys are strictly less than key,
+// in descending key order. It seeks to the last such entry once instead of testing every key.
+// The map must not be modified during iteration.
+// Time complexity: O(log n + k) where k is the number of entries yielded.
+//
+// Parameters:
+//   - key: the exclusive upper bound
+//
+// Returns:
+//   - an iter.Seq2 yielding each key < key and its value in descending key order
+//
+// Example:
+//
+//	for ts, event := range events.DescendBelow(now) {
+//	    fmt.Println("latest before now:", ts, event)
+//	    break
+//	}
+func (tm *TreeMap[K, V]) DescendBelow(key K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		tm.descendBelow(tm.root, key, yield)
+	}
+}
+
+// find returns the node holding key, or nil if the key is absent.
+func (tm *TreeMap[K, V]) find(key K) *node[K, V] {
+	x := tm.root
+	for x != nil {
+		c := tm.compare(key, x.key)
+		switch {
+		case c < 0:
+			x = x.left
+		case c > 0:
+			x = x.right
+		default:
+			return x
+		}
+	}
+	return nil
+}
+
+// put inserts key into the subtree rooted at h and returns the new subtree root.
+func (tm *TreeMap[K, V]) put(h *node[K, V], key K, value V) *node[K, V] {
+	if h == nil {
+		return &node[K, V]{key: key, value: value, red: true, size: 1}
+	}
+	c := tm.compare(key, h.key)
+	switch {
+	case c < 0:
+		h.left = tm.put(h.left, key, value)
+	case c > 0:
+		h.right = tm.put(h.right, key, value)
+	default:
+		h.value = value
+	}
+	return balance(h)
+}
+
+// delete removes key, which must be present, from the subtree rooted at h and returns the new subtree root.
+func (tm *TreeMap[K, V]) delete(h *node[K, V], key K) *node[K, V] {
+	if tm.compare(key, h.key) < 0 {
+		if !isRed(h.left) && !isRed(h.left.left) {
+			h = moveRedLeft(h)
+		}
+		h.left = tm.delete(h.left, key)
+		return balance(h)
+	}
+	if isRed(h.left) {
+		h = rotateRight(h)
+	}
+	if tm.compare(key, h.key) == 0 && h.right == nil {
+		return nil
+	}
+	if !isRed(h.right) && !isRed(h.right.left) {
+		h = moveRedRight(h)
+	}
+	if tm.compare(key, h.key) == 0 {
+		successor := minNode(h.right)
+		h.key, h.value = successor.key, successor.value
+		h.right = deleteMin(h.right)
+	} else {
+		h.right = tm.delete(h.right, key)
+	}
+	return balance(h)
+}
+
+// ascendRange yields the entries of the subtree rooted at x with keys in [from, to), in order.
+// It returns false if yield asked to stop.
+func (tm *TreeMap[K, V]) ascendRange(x *node[K, V], from, to K, yield func(K, V) bool) bool {
+	if x == nil {
+		return true
+	}
+	afterFrom := tm.compare(from, x.key) <= 0
+	beforeTo := tm.compare(x.key, to) < 0
+	if afterFrom && !tm.ascendRange(x.left, from, to, yield) {
+		return false
+	}
+	if afterFrom && beforeTo && !yield(x.key, x.value) {
+		return false
+	}
+	if beforeTo {
+		return tm.ascendRange(x.right, from, to, yield)
+	}
+	return true
+}
+
+// ascendFrom yields the entries of the subtree rooted at x with keys >= from, in ascending order.
+// It returns false if yield asked to stop.
+func (tm *TreeMap[K, V]) ascendFrom(x *node[K, V], from K, yield func(K, V) bool) bool {
+	if x == nil {
+		return true
+	}
+	if tm.compare(from, x.key) > 0 {
+		return tm.ascendFrom(x.right, from, yield)
+	}
+	return tm.ascendFrom(x.left, from, yield) && yield(x.key, x.value) && ascend(x.right, yield)
+}
+
+// descendBelow yields the entries of the subtree rooted at x with keys < to, in descending order.
+// It returns false if yield asked to stop.
+func (tm *TreeMap[K, V]) descendBelow(x *node[K, V], to K, yield func(K, V) bool) bool {
+	if x == nil {
+		return true
+	}
+	if tm.compare(x.key, to) >= 0 {
+		return tm.descendBelow(x.left, to, yield)
+	}
+	return tm.descendBelow(x.right, to, yield) && yield(x.key, x.value) && descend(x.left, yield)
+}
+
+// ascend yields the entries of the subtree rooted at x in ascending order.
+// It returns false if yield asked to stop.
+func ascend[K, V any](x *node[K, V], yield func(K, V) bool) bool {
+	if x == nil {
+		return true
+	}
+	return ascend(x.left, yield) && yield(x.key, x.value) && ascend(x.right, yield)
+}
+
+// descend yields the entries of the subtree rooted at x in descending order.
+// It returns false if yield asked to stop.
+func descend[K, V any](x *node[K, V], yield func(K, V) bool) bool {
+	if x == nil {
+		return true
+	}
+	return descend(x.right, yield) && yield(x.key, x.value) && descend(x.left, yield)
+}
+
+// entry unpacks x into the (key, value, ok) triple returned by lookups; a nil x yields zero values and false.
+func entry[K, V any](x *node[K, V]) (K, V, bool) {
+	if x == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return x.key, x.value, true
+}
+
+// minNode returns the node with the smallest key in the non-empty subtree rooted at x.
+func minNode[K, V any](x *node[K, V]) *node[K, V] {
+	for x.left != nil {
+		x = x.left
+	}
+	return x
+}
+
+// maxNode returns the node with the largest key in the non-empty subtree rooted at x.
+func maxNode[K, V any](x *node[K, V]) *node[K, V] {
+	for x.right != nil {
+		x = x.right
+	}
+	return x
+}
+
+// deleteMin removes the smallest key from the subtree rooted at h and returns the new subtree root.
+func deleteMin[K, V any](h *node[K, V]) *node[K, V] {
+	if h.left == nil {
+		return nil
+	}
+	if !isRed(h.left) && !isRed(h.left.left) {
+		h = moveRedLeft(h)
+	}
+	h.left = deleteMin(h.left)
+	return balance(h)
+}
+
+// isRed reports whether x is a red node; nil links are black.
+func isRed[K, V any](x *node[K, V]) bool {
+	return x != nil && x.red
+}
+
+// size returns the number of nodes in the subtree rooted at x.
+func size[K, V any](x *node[K, V]) int {
+	if x == nil {
+		return 0
+	}
+	return x.size
+}
+
+// rotateLeft turns a right-leaning red link of h into a left-leaning one.
+func rotateLeft[K, V any](h *node[K, V]) *node[K, V] {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	x.size = h.size
+	h.size = size(h.left) + size(h.right) + 1
+	return x
+}
+
+// rotateRight turns a left-leaning red link of h into a right-leaning one.
+func rotateRight[K, V any](h *node[K, V]) *node[K, V] {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	x.size = h.size
+	h.size = size(h.left) + size(h.right) + 1
+	return x
+}
+
+// flipColors flips the colors of h and its two children.
+func flipColors[K, V any](h *node[K, V]) {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+// moveRedLeft makes h.left or one of its children red, assuming h is red and both children are black.
+func moveRedLeft[K, V any](h *node[K, V]) *node[K, V] {
+	flipColors(h)
+	if isRed(h.right.left) {
+		h.right = rotateRight(h.right)
+		h = rotateLeft(h)
+		flipColors(h)
+	}
+	return h
+}
+
+// moveRedRight makes h.right or one of its children red, assuming h is red and both children are black.
+func moveRedRight[K, V any](h *node[K, V]) *node[K, V] {
+	flipColors(h)
+	if isRed(h.left.left) {
+		h = rotateRight(h)
+		flipColors(h)
+	}
+	return h
+}
+
+// balance restores the left-leaning red-black invariants at h and updates its size.
+func balance[K, V any](h *node[K, V]) *node[K, V] {
+	if isRed(h.right) && !isRed(h.left) {
+		h = rotateLeft(h)
+	}
+	if isRed(h.left) && isRed(h.left.left) {
+		h = rotateRight(h)
+	}
+	if isRed(h.left) && isRed(h.right) {
+		flipColors(h)
+	}
+	h.size = size(h.left) + size(h.right) + 1
+	return h
+}
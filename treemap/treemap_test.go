@@ -0,0 +1,544 @@
+package treemap
+
+import (
+	"cmp"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// checkInvariants verifies the left-leaning red-black tree invariants and subtree sizes.
+func checkInvariants[K, V any](t *testing.T, tm *TreeMap[K, V]) {
+	t.Helper()
+	if isRed(tm.root) {
+		t.Fatal("Expected root to be black")
+	}
+	var walk func(x *node[K, V]) int
+	walk = func(x *node[K, V]) int {
+		if x == nil {
+			return 1
+		}
+		if isRed(x.right) {
+			t.Fatalf("Expected no right-leaning red link at %v", x.key)
+		}
+		if isRed(x) && isRed(x.left) {
+			t.Fatalf("Expected no consecutive red links at %v", x.key)
+		}
+		if x.left != nil && tm.compare(x.left.key, x.key) >= 0 {
+			t.Fatalf("Expected left child of %v to be smaller, got %v", x.key, x.left.key)
+		}
+		if x.right != nil && tm.compare(x.right.key, x.key) <= 0 {
+			t.Fatalf("Expected right child of %v to be larger, got %v", x.key, x.right.key)
+		}
+		if want := size(x.left) + size(x.right) + 1; x.size != want {
+			t.Fatalf("Expected size %d at %v, got %d", want, x.key, x.size)
+		}
+		leftHeight, rightHeight := walk(x.left), walk(x.right)
+		if leftHeight != rightHeight {
+			t.Fatalf("Expected equal black heights at %v, got %d and %d", x.key, leftHeight, rightHeight)
+		}
+		if isRed(x) {
+			return leftHeight
+		}
+		return leftHeight + 1
+	}
+	walk(tm.root)
+}
+
+func TestNew(t *testing.T) {
+	t.Run("New ordered map", func(t *testing.T) {
+		tm := New[int, string]()
+		if tm == nil {
+			t.Fatal("Expected map to be initialized, got nil")
+		}
+		if tm.Size() != 0 {
+			t.Errorf("Expected size 0, got %d", tm.Size())
+		}
+		if !tm.IsEmpty() {
+			t.Error("Expected map to be empty")
+		}
+	})
+
+	t.Run("NewFunc with custom order", func(t *testing.T) {
+		tm := NewFunc[string, int](func(a, b string) int {
+			return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+		})
+		for _, key := range []string{"ccc", "a", "bb", "b"} {
+			tm.Put(key, len(key))
+		}
+		expected := []string{"a", "b", "bb", "ccc"}
+		if !reflect.DeepEqual(tm.Keys(), expected) {
+			t.Errorf("Expected %v, got %v", expected, tm.Keys())
+		}
+	})
+
+	t.Run("NewFunc with reverse order", func(t *testing.T) {
+		tm := NewFunc[int, int](func(a, b int) int { return cmp.Compare(b, a) })
+		for i := range 5 {
+			tm.Put(i, i)
+		}
+		expected := []int{4, 3, 2, 1, 0}
+		if !reflect.DeepEqual(tm.Keys(), expected) {
+			t.Errorf("Expected %v, got %v", expected, tm.Keys())
+		}
+	})
+}
+
+func TestTreeMapPutGetDelete(t *testing.T) {
+	t.Run("Put and Get", func(t *testing.T) {
+		tm := New[string, int]()
+		tm.Put("b", 2)
+		tm.Put("a", 1)
+		tm.Put("c", 3)
+
+		if tm.Size() != 3 {
+			t.Errorf("Expected size 3, got %d", tm.Size())
+		}
+		if value, ok := tm.Get("a"); !ok || value != 1 {
+			t.Errorf("Expected (1, true), got (%d, %t)", value, ok)
+		}
+		if value, ok := tm.Get("z"); ok || value != 0 {
+			t.Errorf("Expected (0, false), got (%d, %t)", value, ok)
+		}
+		if !tm.Contains("c") || tm.Contains("z") {
+			t.Error("Expected Contains to report c present and z absent")
+		}
+	})
+
+	t.Run("Put updates existing key", func(t *testing.T) {
+		tm := New[string, int]()
+		tm.Put("a", 1)
+		tm.Put("a", 10)
+		if tm.Size() != 1 {
+			t.Errorf("Expected size 1, got %d", tm.Size())
+		}
+		if value, _ := tm.Get("a"); value != 10 {
+			t.Errorf("Expected 10, got %d", value)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		tm := New[int, int]()
+		for i := range 10 {
+			tm.Put(i, i*i)
+		}
+		if !tm.Delete(4) {
+			t.Error("Expected Delete of existing key to return true")
+		}
+		if tm.Delete(4) {
+			t.Error("Expected second Delete to return false")
+		}
+		if tm.Delete(100) {
+			t.Error("Expected Delete of missing key to return false")
+		}
+		if tm.Size() != 9 || tm.Contains(4) {
+			t.Errorf("Expected 9 entries without 4, got %v", tm.Keys())
+		}
+		checkInvariants(t, tm)
+	})
+
+	t.Run("Delete until empty", func(t *testing.T) {
+		tm := New[int, int]()
+		tm.Put(1, 1)
+		tm.Delete(1)
+		if !tm.IsEmpty() {
+			t.Error("Expected map to be empty")
+		}
+		if empty := New[int, int](); empty.Delete(1) {
+			t.Error("Expected Delete on empty map to return false")
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		tm := New[int, int]()
+		for i := range 10 {
+			tm.Put(i, i)
+		}
+		tm.Clear()
+		if !tm.IsEmpty() || len(tm.Keys()) != 0 {
+			t.Error("Expected map to be empty after Clear")
+		}
+		tm.Put(1, 1)
+		if tm.Size() != 1 {
+			t.Errorf("Expected size 1 after reuse, got %d", tm.Size())
+		}
+	})
+}
+
+func TestTreeMapMinMax(t *testing.T) {
+	tm := New[int, string]()
+	if _, _, ok := tm.Min(); ok {
+		t.Error("Expected Min on empty map to fail")
+	}
+	if _, _, ok := tm.Max(); ok {
+		t.Error("Expected Max on empty map to fail")
+	}
+	if _, _, ok := tm.PopMin(); ok {
+		t.Error("Expected PopMin on empty map to fail")
+	}
+	if _, _, ok := tm.PopMax(); ok {
+		t.Error("Expected PopMax on empty map to fail")
+	}
+
+	for _, key := range []int{5, 3, 8, 1, 9} {
+		tm.Put(key, string(rune('a'+key)))
+	}
+	if key, value, ok := tm.Min(); !ok || key != 1 || value != "b" {
+		t.Errorf("Expected (1, b, true), got (%d, %s, %t)", key, value, ok)
+	}
+	if key, value, ok := tm.Max(); !ok || key != 9 || value != "j" {
+		t.Errorf("Expected (9, j, true), got (%d, %s, %t)", key, value, ok)
+	}
+
+	var popped []int
+	for key, _, ok := tm.PopMin(); ok; key, _, ok = tm.PopMin() {
+		popped = append(popped, key)
+		checkInvariants(t, tm)
+	}
+	if !reflect.DeepEqual(popped, []int{1, 3, 5, 8, 9}) {
+		t.Errorf("Expected ascending pops, got %v", popped)
+	}
+
+	for _, key := range []int{5, 3, 8} {
+		tm.Put(key, "")
+	}
+	if key, _, ok := tm.PopMax(); !ok || key != 8 {
+		t.Errorf("Expected (8, true), got (%d, %t)", key, ok)
+	}
+	if !reflect.DeepEqual(tm.Keys(), []int{3, 5}) {
+		t.Errorf("Expected [3 5], got %v", tm.Keys())
+	}
+}
+
+func TestTreeMapNavigation(t *testing.T) {
+	tm := New[int, int]()
+	for _, key := range []int{10, 20, 30, 40} {
+		tm.Put(key, key*10)
+	}
+
+	tests := []struct {
+		name     string
+		lookup   func(int) (int, int, bool)
+		key      int
+		expected int
+		ok       bool
+	}{
+		{"Floor exact", tm.Floor, 20, 20, true},
+		{"Floor between", tm.Floor, 25, 20, true},
+		{"Floor below all", tm.Floor, 5, 0, false},
+		{"Floor above all", tm.Floor, 50, 40, true},
+		{"Ceiling exact", tm.Ceiling, 20, 20, true},
+		{"Ceiling between", tm.Ceiling, 25, 30, true},
+		{"Ceiling below all", tm.Ceiling, 5, 10, true},
+		{"Ceiling above all", tm.Ceiling, 50, 0, false},
+		{"Lower exact", tm.Lower, 20, 10, true},
+		{"Lower between", tm.Lower, 25, 20, true},
+		{"Lower smallest", tm.Lower, 10, 0, false},
+		{"Higher exact", tm.Higher, 20, 30, true},
+		{"Higher between", tm.Higher, 25, 30, true},
+		{"Higher largest", tm.Higher, 40, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, value, ok := tt.lookup(tt.key)
+			if ok != tt.ok || key != tt.expected {
+				t.Errorf("Expected (%d, %t), got (%d, %t)", tt.expected, tt.ok, key, ok)
+			}
+			if ok && value != key*10 {
+				t.Errorf("Expected value %d, got %d", key*10, value)
+			}
+		})
+	}
+
+	t.Run("Empty map", func(t *testing.T) {
+		empty := New[int, int]()
+		for _, lookup := range []func(int) (int, int, bool){empty.Floor, empty.Ceiling, empty.Lower, empty.Higher} {
+			if _, _, ok := lookup(1); ok {
+				t.Error("Expected lookup on empty map to fail")
+			}
+		}
+	})
+}
+
+func TestTreeMapRankSelect(t *testing.T) {
+	tm := New[int, int]()
+	for _, key := range []int{10, 20, 30, 40} {
+		tm.Put(key, key)
+	}
+
+	ranks := map[int]int{5: 0, 10: 0, 15: 1, 20: 1, 40: 3, 45: 4}
+	for key, expected := range ranks {
+		if rank := tm.Rank(key); rank != expected {
+			t.Errorf("Expected Rank(%d) = %d, got %d", key, expected, rank)
+		}
+	}
+
+	for i, expected := range []int{10, 20, 30, 40} {
+		if key, value, ok := tm.Select(i); !ok || key != expected || value != expected {
+			t.Errorf("Expected Select(%d) = %d, got (%d, %d, %t)", i, expected, key, value, ok)
+		}
+	}
+	for _, rank := range []int{-1, 4} {
+		if _, _, ok := tm.Select(rank); ok {
+			t.Errorf("Expected Select(%d) to fail", rank)
+		}
+	}
+}
+
+func TestTreeMapIterators(t *testing.T) {
+	tm := New[int, string]()
+	for _, key := range []int{3, 1, 4, 5, 9, 2, 6} {
+		tm.Put(key, string(rune('0'+key)))
+	}
+
+	t.Run("All and Backward", func(t *testing.T) {
+		var forward, backward []int
+		for key := range tm.All() {
+			forward = append(forward, key)
+		}
+		for key := range tm.Backward() {
+			backward = append(backward, key)
+		}
+		if !reflect.DeepEqual(forward, []int{1, 2, 3, 4, 5, 6, 9}) {
+			t.Errorf("Unexpected All order %v", forward)
+		}
+		if !reflect.DeepEqual(backward, []int{9, 6, 5, 4, 3, 2, 1}) {
+			t.Errorf("Unexpected Backward order %v", backward)
+		}
+	})
+
+	t.Run("Keys, Values and ToSlice", func(t *testing.T) {
+		if !reflect.DeepEqual(tm.Values(), []string{"1", "2", "3", "4", "5", "6", "9"}) {
+			t.Errorf("Unexpected values %v", tm.Values())
+		}
+		pairs := tm.ToSlice()
+		if len(pairs) != 7 || pairs[0] != (KVPair[int, string]{Key: 1, Value: "1"}) {
+			t.Errorf("Unexpected pairs %v", pairs)
+		}
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		tests := []struct {
+			from, to int
+			expected []int
+		}{
+			{2, 6, []int{2, 3, 4, 5}},
+			{0, 100, []int{1, 2, 3, 4, 5, 6, 9}},
+			{7, 9, nil},
+			{6, 10, []int{6, 9}},
+			{5, 5, nil},
+			{6, 2, nil},
+		}
+		for _, tt := range tests {
+			var keys []int
+			for key := range tm.Range(tt.from, tt.to) {
+				keys = append(keys, key)
+			}
+			if !reflect.DeepEqual(keys, tt.expected) {
+				t.Errorf("Expected Range(%d, %d) = %v, got %v", tt.from, tt.to, tt.expected, keys)
+			}
+		}
+	})
+
+	t.Run("AscendFrom and DescendBelow", func(t *testing.T) {
+		tests := []struct {
+			key             int
+			ascend, descend []int
+		}{
+			{0, []int{1, 2, 3, 4, 5, 6, 9}, nil},
+			{4, []int{4, 5, 6, 9}, []int{3, 2, 1}},
+			{7, []int{9}, []int{6, 5, 4, 3, 2, 1}},
+			{10, nil, []int{9, 6, 5, 4, 3, 2, 1}},
+		}
+		for _, tt := range tests {
+			var ascend, descend []int
+			for key := range tm.AscendFrom(tt.key) {
+				ascend = append(ascend, key)
+			}
+			for key := range tm.DescendBelow(tt.key) {
+				descend = append(descend, key)
+			}
+			if !reflect.DeepEqual(ascend, tt.ascend) {
+				t.Errorf("Expected AscendFrom(%d) = %v, got %v", tt.key, tt.ascend, ascend)
+			}
+			if !reflect.DeepEqual(descend, tt.descend) {
+				t.Errorf("Expected DescendBelow(%d) = %v, got %v", tt.key, tt.descend, descend)
+			}
+		}
+	})
+
+	t.Run("Early termination", func(t *testing.T) {
+		var keys []int
+		for key := range tm.Range(2, 100) {
+			if key > 4 {
+				break
+			}
+			keys = append(keys, key)
+		}
+		if !reflect.DeepEqual(keys, []int{2, 3, 4}) {
+			t.Errorf("Expected [2 3 4], got %v", keys)
+		}
+		keys = nil
+		for key := range tm.AscendFrom(3) {
+			if key > 4 {
+				break
+			}
+			keys = append(keys, key)
+		}
+		if !reflect.DeepEqual(keys, []int{3, 4}) {
+			t.Errorf("Expected [3 4], got %v", keys)
+		}
+		for key := range tm.DescendBelow(6) {
+			if key != 5 {
+				t.Errorf("Expected to stop after 5, got %d", key)
+			}
+			break
+		}
+		count := 0
+		for range tm.Backward() {
+			count++
+			if count == 2 {
+				break
+			}
+		}
+		if count != 2 {
+			t.Errorf("Expected 2 iterations, got %d", count)
+		}
+	})
+}
+
+func TestTreeMapRandomized(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tm := New[int, int]()
+	model := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(500)
+		switch rng.Intn(3) {
+		case 0, 1:
+			tm.Put(key, i)
+			model[key] = i
+		default:
+			_, present := model[key]
+			if deleted := tm.Delete(key); deleted != present {
+				t.Fatalf("Expected Delete(%d) = %t, got %t", key, present, deleted)
+			}
+			delete(model, key)
+		}
+
+		if i%250 == 0 {
+			checkInvariants(t, tm)
+		}
+	}
+	checkInvariants(t, tm)
+
+	keys := make([]int, 0, len(model))
+	for key := range model {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	if !reflect.DeepEqual(tm.Keys(), keys) {
+		t.Fatalf("Expected keys %v, got %v", keys, tm.Keys())
+	}
+	for rank, key := range keys {
+		if got := tm.Rank(key); got != rank {
+			t.Errorf("Expected Rank(%d) = %d, got %d", key, rank, got)
+		}
+		if got, value, _ := tm.Select(rank); got != key || value != model[key] {
+			t.Errorf("Expected Select(%d) = (%d, %d), got (%d, %d)", rank, key, model[key], got, value)
+		}
+	}
+	for probe := -1; probe <= 501; probe++ {
+		i, found := slices.BinarySearch(keys, probe)
+		floor, _, ok := tm.Floor(probe)
+		switch {
+		case found:
+			if !ok || floor != probe {
+				t.Errorf("Expected Floor(%d) = %d, got (%d, %t)", probe, probe, floor, ok)
+			}
+		case i == 0:
+			if ok {
+				t.Errorf("Expected Floor(%d) to fail, got %d", probe, floor)
+			}
+		default:
+			if !ok || floor != keys[i-1] {
+				t.Errorf("Expected Floor(%d) = %d, got (%d, %t)", probe, keys[i-1], floor, ok)
+			}
+		}
+		higher, _, ok := tm.Higher(probe)
+		if found {
+			i++
+		}
+		if i == len(keys) {
+			if ok {
+				t.Errorf("Expected Higher(%d) to fail, got %d", probe, higher)
+			}
+		} else if !ok || higher != keys[i] {
+			t.Errorf("Expected Higher(%d) = %d, got (%d, %t)", probe, keys[i], higher, ok)
+		}
+	}
+}
+
+// Benchmark tests
+func BenchmarkTreeMapPut(b *testing.B) {
+	tm := New[int, int]()
+	rng := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tm.Put(rng.Int(), i)
+	}
+}
+
+func BenchmarkTreeMapGet(b *testing.B) {
+	tm := New[int, int]()
+	for i := 0; i < 1<<16; i++ {
+		tm.Put(i, i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tm.Get(i & (1<<16 - 1))
+	}
+}
+
+func BenchmarkTreeMapDelete(b *testing.B) {
+	tm := New[int, int]()
+	for i := 0; i < b.N; i++ {
+		tm.Put(i, i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tm.Delete(i)
+	}
+}
+
+func BenchmarkTreeMapFloor(b *testing.B) {
+	tm := New[int, int]()
+	for i := 0; i < 1<<16; i++ {
+		tm.Put(i*2, i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tm.Floor(i & (1<<17 - 1))
+	}
+}
+
+func BenchmarkTreeMapRange(b *testing.B) {
+	tm := New[int, int]()
+	for i := 0; i < 1<<16; i++ {
+		tm.Put(i, i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		from := i & (1<<16 - 1)
+		for range tm.Range(from, from+64) {
+		}
+	}
+}
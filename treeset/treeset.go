@@ -0,0 +1,716 @@
+// Package treeset provides a sorted set implementation backed by a red-black tree.
+// Elements are kept in order, enabling ordered iteration, floor/ceiling lookups
+// and range views in addition to the usual set operations.
+package treeset
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+
+	"github.com/thefrost13/gollections/treemap"
+)
+
+// TreeSet is a generic set data structure that keeps its elements sorted.
+// It is backed by a treemap.TreeMap, so insertion, deletion and lookups run in O(log n) time.
+// A TreeSet returned by HeadSet, TailSet or SubSet is a view of a range of its parent:
+// both share the same underlying tree, so changes made through one are visible through the other.
+// A TreeSet must be created with New, NewFunc or FromSeq.
+//
+// Type parameters:
+//   - T: the element type, ordered by the set's compare function
+type TreeSet[T any] struct {
+	tree    *treemap.TreeMap[T, struct{}] // the underlying tree, shared between a set and its views
+	compare func(a, b T) int              // the ordering of the tree
+	from    T                             // inclusive lower bound of a view, valid if hasFrom
+	to      T                             // exclusive upper bound of a view, valid if hasTo
+	hasFrom bool                          // whether the set is bounded below
+	hasTo   bool                          // whether the set is bounded above
+}
+
+// New creates and returns a new TreeSet initialized with the elements from the given slice,
+// ordered with cmp.Compare. Duplicate elements in the slice are automatically removed.
+// If the slice is nil, an empty set is returned.
+// Time complexity: O(n log n) where n is the length of the slice.
+//
+// Parameters:
+//   - sli: slice of elements to initialize the set with, can be nil
+//
+// Returns:
+//   - a new TreeSet containing the unique elements from the slice
+//
+// Example:
+//
+//	set := New([]int{3, 1, 2, 1})  // Creates set with {1, 2, 3}
+//	emptySet := New[string](nil)   // Creates empty set
+func New[T cmp.Ordered](sli []T) *TreeSet[T] {
+	return NewFunc(cmp.Compare[T], sli)
+}
+
+// NewFunc creates and returns a new TreeSet initialized with the elements from the given slice,
+// ordered with the given compare function. Elements that compare equal are treated as duplicates.
+// Time complexity: O(n log n) where n is the length of the slice.
+//
+// Parameters:
+//   - compare: the function used to order elements, returning a negative number, zero or a positive number
+//   - sli: slice of elements to initialize the set with, can be nil
+//
+// Returns:
+//   - a new TreeSet containing the unique elements from the slice
+//
+// Example:
+//
+//	byLength := NewFunc(func(a, b string) int {
+//	    return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+//	}, []string{"ccc", "a", "bb"})
+func NewFunc[T any](compare func(a, b T) int, sli []T) *TreeSet[T] {
+	set := &TreeSet[T]{tree: treemap.NewFunc[T, struct{}](compare), compare: compare}
+	for _, v := range sli {
+		set.tree.Put(v, struct{}{})
+	}
+	return set
+}
+
+// FromSeq creates and returns a new TreeSet containing the unique elements yielded by seq,
+// ordered with cmp.Compare.
+// Time complexity: O(n log n) where n is the number of elements yielded.
+//
+// Parameters:
+//   - seq: the sequence of elements to initialize the set with
+//
+// Returns:
+//   - a new TreeSet containing the unique elements from the sequence
+//
+// Example:
+//
+//	set := FromSeq(maps.Keys(counts))  // Creates a sorted set of the map's keys
+func FromSeq[T cmp.Ordered](seq iter.Seq[T]) *TreeSet[T] {
+	set := New[T](nil)
+	for v := range seq {
+		set.tree.Put(v, struct{}{})
+	}
+	return set
+}
+
+// Add inserts an element into the set.
+// If the element already exists, the operation is a no-op.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - value: the element to add to the set
+//
+// Panics if the set is a view and value lies outside its range.
+//
+// Example:
+//
+//	set.Add(42)
+func (s *TreeSet[T]) Add(value T) {
+	if !s.inRange(value) {
+		panic(fmt.Sprintf("treeset: Add: value %v outside view range", value))
+	}
+	s.tree.Put(value, struct{}{})
+}
+
+// Remove deletes an element from the set.
+// If the element doesn't exist, or lies outside the range of a view, the operation is a no-op.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - value: the element to remove from the set
+//
+// Example:
+//
+//	set.Remove(42)
+func (s *TreeSet[T]) Remove(value T) {
+	if s.inRange(value) {
+		s.tree.Delete(value)
+	}
+}
+
+// Contains checks if an element exists in the set.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - value: the element to check for membership
+//
+// Returns:
+//   - true if the element exists in the set, false otherwise
+//
+// Example:
+//
+//	if set.Contains(42) {
+//	    fmt.Println("Set contains 42")
+//	}
+func (s *TreeSet[T]) Contains(value T) bool {
+	return s.inRange(value) && s.tree.Contains(value)
+}
+
+// Size returns the number of elements in the set.
+// Time complexity: O(1), or O(log n) for a view.
+//
+// Returns:
+//   - the number of elements in the set
+func (s *TreeSet[T]) Size() int {
+	start, end := s.ranks()
+	return end - start
+}
+
+// IsEmpty returns true if the set contains no elements.
+// Time complexity: O(1), or O(log n) for a view.
+//
+// Returns:
+//   - true if the set is empty, false otherwise
+func (s *TreeSet[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Clear removes all elements from the set. Clearing a view removes only the elements in its range.
+// Time complexity: O(1), or O(k log n) for a view holding k elements.
+//
+// Example:
+//
+//	set.HeadSet(10).Clear()  // Remove every element below 10
+func (s *TreeSet[T]) Clear() {
+	if !s.hasFrom && !s.hasTo {
+		s.tree.Clear()
+		return
+	}
+	for _, v := range s.ToSlice() {
+		s.tree.Delete(v)
+	}
+}
+
+// First returns the smallest element in the set.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Returns:
+//   - the smallest element and true, or the zero value and false if the set is empty
+//
+// Example:
+//
+//	if lowest, ok := set.First(); ok {
+//	    fmt.Println(lowest)
+//	}
+func (s *TreeSet[T]) First() (T, bool) {
+	var v T
+	var ok bool
+	if s.hasFrom {
+		v, _, ok = s.tree.Ceiling(s.from)
+	} else {
+		v, _, ok = s.tree.Min()
+	}
+	return s.checked(v, ok)
+}
+
+// Last returns the largest element in the set.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Returns:
+//   - the largest element and true, or the zero value and false if the set is empty
+func (s *TreeSet[T]) Last() (T, bool) {
+	var v T
+	var ok bool
+	if s.hasTo {
+		v, _, ok = s.tree.Lower(s.to)
+	} else {
+		v, _, ok = s.tree.Max()
+	}
+	return s.checked(v, ok)
+}
+
+// Floor returns the largest element in the set less than or equal to the given value.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - value: the value to search from
+//
+// Returns:
+//   - the floor element and true, or the zero value and false if no such element exists
+//
+// Example:
+//
+//	set := New([]int{10, 20, 30})
+//	set.Floor(25)  // 20, true
+func (s *TreeSet[T]) Floor(value T) (T, bool) {
+	if s.hasTo && s.compare(value, s.to) >= 0 {
+		return s.Last()
+	}
+	v, _, ok := s.tree.Floor(value)
+	return s.checked(v, ok)
+}
+
+// Ceiling returns the smallest element in the set greater than or equal to the given value.
+// Time complexity: O(log n) where n is the number of elements.
+//
+// Parameters:
+//   - value: the value to search from
+//
+// Returns:
+//   - the ceiling element and true, or the zero value and false if no such element exists
+//
+// Example:
+//
+//	set := New([]int{10, 20, 30})
+//	set.Ceiling(25)  // 30, true
+func (s *TreeSet[T]) Ceiling(value T) (T, bool) {
+	if s.hasFrom && s.compare(value, s.from) < 0 {
+		return s.First()
+	}
+	v, _, ok := s.tree.Ceiling(value)
+	return s.checked(v, ok)
+}
+
+// HeadSet returns a view of the elements strictly less than to.
+// The view shares the underlying tree with this set; adding an element outside its range panics.
+// The view of a view is limited to the range of both.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - to: the exclusive upper bound
+//
+// Returns:
+//   - a TreeSet view of the elements less than to
+//
+// Example:
+//
+//	set := New([]int{1, 2, 3, 4})
+//	head := set.HeadSet(3)  // {1, 2}
+//	set.Add(0)              // head is now {0, 1, 2}
+func (s *TreeSet[T]) HeadSet(to T) *TreeSet[T] {
+	var from T
+	return s.view(from, false, to, true)
+}
+
+// TailSet returns a view of the elements greater than or equal to from.
+// The view shares the underlying tree with this set; adding an element outside its range panics.
+// The view of a view is limited to the range of both.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - from: the inclusive lower bound
+//
+// Returns:
+//   - a TreeSet view of the elements greater than or equal to from
+//
+// Example:
+//
+//	set := New([]int{1, 2, 3, 4})
+//	tail := set.TailSet(3)  // {3, 4}
+func (s *TreeSet[T]) TailSet(from T) *TreeSet[T] {
+	var to T
+	return s.view(from, true, to, false)
+}
+
+// SubSet returns a view of the elements in the half-open range [from, to).
+// If from is not less than to, the view is empty.
+// The view shares the underlying tree with this set; adding an element outside its range panics.
+// The view of a view is limited to the range of both.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - from: the inclusive lower bound
+//   - to: the exclusive upper bound
+//
+// Returns:
+//   - a TreeSet view of the elements in [from, to)
+//
+// Example:
+//
+//	set := New([]int{1, 2, 3, 4})
+//	sub := set.SubSet(2, 4)  // {2, 3}
+//	sub.Remove(2)            // set is now {1, 3, 4}
+func (s *TreeSet[T]) SubSet(from, to T) *TreeSet[T] {
+	return s.view(from, true, to, true)
+}
+
+// ToSlice returns a slice containing all elements in ascending order.
+// The returned slice is a copy and modifications to it will not affect the set.
+// Time complexity: O(n) where n is the number of elements, or O(log n + k) for a view holding k elements.
+//
+// Returns:
+//   - a slice containing all elements in ascending order
+func (s *TreeSet[T]) ToSlice() []T {
+	slice := make([]T, 0, s.Size())
+	for v := range s.All() {
+		slice = append(slice, v)
+	}
+	return slice
+}
+
+// All returns an iterator over the elements of the set in ascending order.
+// The set must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, or O(log n + k) for a view holding k elements.
+//
+// Returns:
+//   - an iter.Seq yielding each element in ascending order
+//
+// Example:
+//
+//	for v := range set.All() {
+//	    fmt.Println(v)
+//	}
+func (s *TreeSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		entries := s.tree.All()
+		if s.hasFrom {
+			entries = s.tree.AscendFrom(s.from)
+		}
+		for v := range entries {
+			if s.hasTo && s.compare(v, s.to) >= 0 || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the set in descending order.
+// The set must not be modified during iteration.
+// Time complexity: O(n) for a full iteration, or O(log n + k) for a view holding k elements.
+//
+// Returns:
+//   - an iter.Seq yielding each element in descending order
+func (s *TreeSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		entries := s.tree.Backward()
+		if s.hasTo {
+			entries = s.tree.DescendBelow(s.to)
+		}
+		for v := range entries {
+			if s.hasFrom && s.compare(v, s.from) < 0 || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Equals checks if this set contains exactly the same elements as another set.
+// Both sets are expected to use the same ordering.
+// Time complexity: O(n log m) where n and m are the sizes of the two sets.
+//
+// Parameters:
+//   - other: the TreeSet to compare with
+//
+// Returns:
+//   - true if both sets contain the same elements, false otherwise
+func (s *TreeSet[T]) Equals(other *TreeSet[T]) bool {
+	return s.Size() == other.Size() && s.IsSubsetOf(other)
+}
+
+// Clone returns a copy of the set.
+// Cloning a view returns an independent set holding only the elements in its range.
+// Time complexity: O(n log n) where n is the number of elements.
+//
+// Returns:
+//   - a new TreeSet containing the same elements with the same ordering
+//
+// Example:
+//
+//	backup := set.Clone()
+//	set.Clear()  // backup still holds the original elements
+func (s *TreeSet[T]) Clone() *TreeSet[T] {
+	return NewFunc(s.compare, s.ToSlice())
+}
+
+// Union returns a new set containing every element that is in this set or in any of the others.
+// The result uses this set's ordering. Neither this set nor the others are modified.
+// Time complexity: O((n + m) log(n + m)) where n is the size of this set and m is the combined size of the others.
+//
+// Parameters:
+//   - others: the sets to combine with this set
+//
+// Returns:
+//   - a new TreeSet containing the union of all sets
+//
+// Example:
+//
+//	a := New([]int{1, 2})
+//	b := New([]int{2, 3})
+//	u := a.Union(b)  // {1, 2, 3}
+func (s *TreeSet[T]) Union(others ...*TreeSet[T]) *TreeSet[T] {
+	result := s.Clone()
+	result.UnionWith(others...)
+	return result
+}
+
+// Intersection returns a new set containing only the elements present in this set and in all of the others.
+// The smallest operand is iterated, so the cost is bounded by the smallest set.
+// With no arguments, a copy of this set is returned.
+// Time complexity: O(k * m * log n) where k is the size of the smallest set, m the number of sets and n the largest size.
+//
+// Parameters:
+//   - others: the sets to intersect with this set
+//
+// Returns:
+//   - a new TreeSet containing the intersection of all sets
+//
+// Example:
+//
+//	a := New([]int{1, 2, 3})
+//	b := New([]int{2, 3, 4})
+//	i := a.Intersection(b)  // {2, 3}
+func (s *TreeSet[T]) Intersection(others ...*TreeSet[T]) *TreeSet[T] {
+	smallest := s
+	for _, other := range others {
+		if other.Size() < smallest.Size() {
+			smallest = other
+		}
+	}
+
+	result := NewFunc[T](s.compare, nil)
+	for v := range smallest.All() {
+		if s.Contains(v) && containedInAll(v, others) {
+			result.tree.Put(v, struct{}{})
+		}
+	}
+	return result
+}
+
+// Difference returns a new set containing the elements of this set that are not in any of the others.
+// Neither this set nor the others are modified.
+// Time complexity: O(n * m * log n) where n is the size of this set and m is the number of others.
+//
+// Parameters:
+//   - others: the sets whose elements should be excluded
+//
+// Returns:
+//   - a new TreeSet containing the difference
+//
+// Example:
+//
+//	a := New([]int{1, 2, 3})
+//	b := New([]int{2})
+//	d := a.Difference(b)  // {1, 3}
+func (s *TreeSet[T]) Difference(others ...*TreeSet[T]) *TreeSet[T] {
+	result := NewFunc[T](s.compare, nil)
+	for v := range s.All() {
+		if !containedInAny(v, others) {
+			result.tree.Put(v, struct{}{})
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new set containing the elements that are in exactly one of the two sets.
+// Neither set is modified.
+// Time complexity: O((n + m) log(n + m)) where n and m are the sizes of the two sets.
+//
+// Parameters:
+//   - other: the TreeSet to compare with
+//
+// Returns:
+//   - a new TreeSet containing the symmetric difference
+//
+// Example:
+//
+//	a := New([]int{1, 2, 3})
+//	b := New([]int{3, 4})
+//	x := a.SymmetricDifference(b)  // {1, 2, 4}
+func (s *TreeSet[T]) SymmetricDifference(other *TreeSet[T]) *TreeSet[T] {
+	result := NewFunc[T](s.compare, nil)
+	for v := range s.All() {
+		if !other.Contains(v) {
+			result.tree.Put(v, struct{}{})
+		}
+	}
+	for v := range other.All() {
+		if !s.Contains(v) {
+			result.tree.Put(v, struct{}{})
+		}
+	}
+	return result
+}
+
+// IsSubsetOf checks if every element of this set is also contained in another set.
+// The empty set is a subset of every set.
+// Time complexity: O(n log m) where n is the size of this set and m the size of other.
+//
+// Parameters:
+//   - other: the potential superset
+//
+// Returns:
+//   - true if this set is a subset of other, false otherwise
+//
+// Example:
+//
+//	New([]int{1, 2}).IsSubsetOf(New([]int{1, 2, 3}))  // true
+func (s *TreeSet[T]) IsSubsetOf(other *TreeSet[T]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	for v := range s.All() {
+		if !other.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf checks if this set contains every element of another set.
+// Every set is a superset of the empty set.
+// Time complexity: O(m log n) where m is the size of other and n the size of this set.
+//
+// Parameters:
+//   - other: the potential subset
+//
+// Returns:
+//   - true if this set is a superset of other, false otherwise
+//
+// Example:
+//
+//	New([]int{1, 2, 3}).IsSupersetOf(New([]int{1, 2}))  // true
+func (s *TreeSet[T]) IsSupersetOf(other *TreeSet[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsDisjoint checks if this set and another set have no elements in common.
+// The smaller of the two sets is iterated.
+// Time complexity: O(min(n, m) log max(n, m)) where n and m are the sizes of the two sets.
+//
+// Parameters:
+//   - other: the TreeSet to compare with
+//
+// Returns:
+//   - true if the sets share no elements, false otherwise
+//
+// Example:
+//
+//	New([]int{1, 2}).IsDisjoint(New([]int{3, 4}))  // true
+func (s *TreeSet[T]) IsDisjoint(other *TreeSet[T]) bool {
+	small, large := s, other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+	for v := range small.All() {
+		if large.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// UnionWith adds every element of the given sets to this set in place.
+// Time complexity: O(m log(n + m)) where n is the size of this set and m is the combined size of the others.
+//
+// Parameters:
+//   - others: the sets whose elements should be added
+//
+// Panics if this set is a view and an element lies outside its range.
+//
+// Example:
+//
+//	a := New([]int{1, 2})
+//	a.UnionWith(New([]int{3}), New([]int{4}))  // a is now {1, 2, 3, 4}
+func (s *TreeSet[T]) UnionWith(others ...*TreeSet[T]) {
+	for _, other := range others {
+		for _, v := range other.ToSlice() {
+			s.Add(v)
+		}
+	}
+}
+
+// IntersectWith removes from this set every element that is not present in all of the given sets.
+// With no arguments, the set is left unchanged.
+// Time complexity: O(n * m * log n) where n is the size of this set and m is the number of sets.
+//
+// Parameters:
+//   - others: the sets to intersect with
+//
+// Example:
+//
+//	a := New([]int{1, 2, 3})
+//	a.IntersectWith(New([]int{2, 3, 4}))  // a is now {2, 3}
+func (s *TreeSet[T]) IntersectWith(others ...*TreeSet[T]) {
+	for _, v := range s.ToSlice() {
+		if !containedInAll(v, others) {
+			s.tree.Delete(v)
+		}
+	}
+}
+
+// ExceptWith removes from this set every element that is present in any of the given sets.
+// For each operand, the smaller of this set and the operand is iterated.
+// Time complexity: O(sum of min(n, m_i) log n) where n is the size of this set and m_i the size of each other.
+//
+// Parameters:
+//   - others: the sets whose elements should be removed
+//
+// Example:
+//
+//	a := New([]int{1, 2, 3})
+//	a.ExceptWith(New([]int{2}))  // a is now {1, 3}
+func (s *TreeSet[T]) ExceptWith(others ...*TreeSet[T]) {
+	for _, other := range others {
+		if other.Size() < s.Size() {
+			for _, v := range other.ToSlice() {
+				s.Remove(v)
+			}
+			continue
+		}
+		for _, v := range s.ToSlice() {
+			if other.Contains(v) {
+				s.tree.Delete(v)
+			}
+		}
+	}
+}
+
+// view returns a set sharing s's tree, restricted to the intersection of s's range and the given bounds.
+func (s *TreeSet[T]) view(from T, hasFrom bool, to T, hasTo bool) *TreeSet[T] {
+	v := *s
+	if hasFrom && (!v.hasFrom || s.compare(from, v.from) > 0) {
+		v.from, v.hasFrom = from, true
+	}
+	if hasTo && (!v.hasTo || s.compare(to, v.to) < 0) {
+		v.to, v.hasTo = to, true
+	}
+	return &v
+}
+
+// inRange reports whether value lies within the bounds of s.
+func (s *TreeSet[T]) inRange(value T) bool {
+	if s.hasFrom && s.compare(value, s.from) < 0 {
+		return false
+	}
+	return !s.hasTo || s.compare(value, s.to) < 0
+}
+
+// checked passes through a lookup result, discarding it if it lies outside the bounds of s.
+func (s *TreeSet[T]) checked(value T, ok bool) (T, bool) {
+	if !ok || !s.inRange(value) {
+		var zero T
+		return zero, false
+	}
+	return value, true
+}
+
+// ranks returns the half-open range of tree ranks covered by s.
+func (s *TreeSet[T]) ranks() (start, end int) {
+	end = s.tree.Size()
+	if s.hasFrom {
+		start = s.tree.Rank(s.from)
+	}
+	if s.hasTo {
+		end = s.tree.Rank(s.to)
+	}
+	return start, max(start, end)
+}
+
+// containedInAll reports whether value is a member of every set in sets.
+func containedInAll[T any](value T, sets []*TreeSet[T]) bool {
+	for _, set := range sets {
+		if !set.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// containedInAny reports whether value is a member of at least one set in sets.
+func containedInAny[T any](value T, sets []*TreeSet[T]) bool {
+	for _, set := range sets {
+		if set.Contains(value) {
+			return true
+		}
+	}
+	return false
+}
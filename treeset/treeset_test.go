@@ -0,0 +1,472 @@
+package treeset
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// recoverPanic runs fn and returns the value it panicked with, or nil.
+func recoverPanic(fn func()) (r any) {
+	defer func() { r = recover() }()
+	fn()
+	return nil
+}
+
+func TestNew(t *testing.T) {
+	t.Run("New with nil slice", func(t *testing.T) {
+		set := New[int](nil)
+		if set == nil {
+			t.Fatal("New should not return nil")
+		}
+		if set.Size() != 0 || !set.IsEmpty() {
+			t.Errorf("Expected empty set, got size %d", set.Size())
+		}
+	})
+
+	t.Run("New sorts and deduplicates", func(t *testing.T) {
+		set := New([]int{5, 3, 1, 3, 5, 2})
+		expected := []int{1, 2, 3, 5}
+		if !reflect.DeepEqual(set.ToSlice(), expected) {
+			t.Errorf("Expected %v, got %v", expected, set.ToSlice())
+		}
+	})
+
+	t.Run("NewFunc with custom order", func(t *testing.T) {
+		set := NewFunc(func(a, b string) int {
+			return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+		}, []string{"ccc", "a", "bb", "b"})
+		expected := []string{"a", "b", "bb", "ccc"}
+		if !reflect.DeepEqual(set.ToSlice(), expected) {
+			t.Errorf("Expected %v, got %v", expected, set.ToSlice())
+		}
+	})
+
+	t.Run("NewFunc treats equivalent elements as duplicates", func(t *testing.T) {
+		set := NewFunc(func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		}, []string{"Go", "go", "GO"})
+		if set.Size() != 1 {
+			t.Errorf("Expected size 1, got %d", set.Size())
+		}
+	})
+
+	t.Run("FromSeq", func(t *testing.T) {
+		set := FromSeq(slices.Values([]string{"c", "a", "b", "a"}))
+		expected := []string{"a", "b", "c"}
+		if !reflect.DeepEqual(set.ToSlice(), expected) {
+			t.Errorf("Expected %v, got %v", expected, set.ToSlice())
+		}
+	})
+}
+
+func TestTreeSetBasicOperations(t *testing.T) {
+	set := New[int](nil)
+	set.Add(3)
+	set.Add(1)
+	set.Add(3)
+	if set.Size() != 2 {
+		t.Errorf("Expected size 2, got %d", set.Size())
+	}
+	if !set.Contains(1) || set.Contains(2) {
+		t.Error("Expected Contains to report 1 present and 2 absent")
+	}
+
+	set.Remove(1)
+	set.Remove(100)
+	if !reflect.DeepEqual(set.ToSlice(), []int{3}) {
+		t.Errorf("Expected [3], got %v", set.ToSlice())
+	}
+
+	set.Clear()
+	if !set.IsEmpty() {
+		t.Error("Expected set to be empty after Clear")
+	}
+}
+
+func TestTreeSetNavigation(t *testing.T) {
+	set := New([]int{10, 20, 30})
+
+	if v, ok := set.First(); !ok || v != 10 {
+		t.Errorf("Expected (10, true), got (%d, %t)", v, ok)
+	}
+	if v, ok := set.Last(); !ok || v != 30 {
+		t.Errorf("Expected (30, true), got (%d, %t)", v, ok)
+	}
+
+	tests := []struct {
+		name     string
+		lookup   func(int) (int, bool)
+		value    int
+		expected int
+		ok       bool
+	}{
+		{"Floor exact", set.Floor, 20, 20, true},
+		{"Floor between", set.Floor, 25, 20, true},
+		{"Floor below all", set.Floor, 5, 0, false},
+		{"Ceiling exact", set.Ceiling, 20, 20, true},
+		{"Ceiling between", set.Ceiling, 25, 30, true},
+		{"Ceiling above all", set.Ceiling, 35, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if v, ok := tt.lookup(tt.value); v != tt.expected || ok != tt.ok {
+				t.Errorf("Expected (%d, %t), got (%d, %t)", tt.expected, tt.ok, v, ok)
+			}
+		})
+	}
+
+	t.Run("Empty set", func(t *testing.T) {
+		empty := New[int](nil)
+		if _, ok := empty.First(); ok {
+			t.Error("Expected First on empty set to fail")
+		}
+		if _, ok := empty.Last(); ok {
+			t.Error("Expected Last on empty set to fail")
+		}
+	})
+}
+
+func TestTreeSetViews(t *testing.T) {
+	t.Run("HeadSet, TailSet and SubSet", func(t *testing.T) {
+		set := New([]int{1, 2, 3, 4, 5})
+		tests := []struct {
+			name     string
+			view     *TreeSet[int]
+			expected []int
+		}{
+			{"HeadSet", set.HeadSet(3), []int{1, 2}},
+			{"TailSet", set.TailSet(3), []int{3, 4, 5}},
+			{"SubSet", set.SubSet(2, 5), []int{2, 3, 4}},
+			{"SubSet between elements", set.SubSet(0, 100), []int{1, 2, 3, 4, 5}},
+			{"Empty SubSet", set.SubSet(4, 2), []int{}},
+			{"Nested views intersect", set.TailSet(2).HeadSet(4).SubSet(0, 10), []int{2, 3}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := tt.view.ToSlice(); !reflect.DeepEqual(got, tt.expected) {
+					t.Errorf("Expected %v, got %v", tt.expected, got)
+				}
+				if tt.view.Size() != len(tt.expected) {
+					t.Errorf("Expected size %d, got %d", len(tt.expected), tt.view.Size())
+				}
+				backward := slices.Collect(tt.view.Backward())
+				slices.Reverse(backward)
+				if !slices.Equal(backward, tt.expected) {
+					t.Errorf("Expected reversed %v, got %v", tt.expected, backward)
+				}
+			})
+		}
+	})
+
+	t.Run("Views share the underlying tree", func(t *testing.T) {
+		set := New([]int{1, 2, 3, 4})
+		head := set.HeadSet(3)
+		set.Add(0)
+		if !reflect.DeepEqual(head.ToSlice(), []int{0, 1, 2}) {
+			t.Errorf("Expected [0 1 2], got %v", head.ToSlice())
+		}
+		head.Remove(1)
+		head.Remove(4) // outside the view: no-op
+		if !reflect.DeepEqual(set.ToSlice(), []int{0, 2, 3, 4}) {
+			t.Errorf("Expected [0 2 3 4], got %v", set.ToSlice())
+		}
+		head.Add(-1)
+		if !set.Contains(-1) {
+			t.Error("Expected element added through the view to be visible in the set")
+		}
+		head.Clear()
+		if !reflect.DeepEqual(set.ToSlice(), []int{3, 4}) {
+			t.Errorf("Expected [3 4], got %v", set.ToSlice())
+		}
+	})
+
+	t.Run("Views respect their bounds", func(t *testing.T) {
+		set := New([]int{10, 20, 30, 40, 50})
+		sub := set.SubSet(20, 40)
+		if sub.Contains(10) || sub.Contains(40) || !sub.Contains(20) {
+			t.Error("Expected Contains to respect the view range")
+		}
+		if v, ok := sub.First(); !ok || v != 20 {
+			t.Errorf("Expected First (20, true), got (%d, %t)", v, ok)
+		}
+		if v, ok := sub.Last(); !ok || v != 30 {
+			t.Errorf("Expected Last (30, true), got (%d, %t)", v, ok)
+		}
+		if v, ok := sub.Floor(100); !ok || v != 30 {
+			t.Errorf("Expected Floor(100) (30, true), got (%d, %t)", v, ok)
+		}
+		if _, ok := sub.Floor(15); ok {
+			t.Error("Expected Floor below the view to fail")
+		}
+		if v, ok := sub.Ceiling(0); !ok || v != 20 {
+			t.Errorf("Expected Ceiling(0) (20, true), got (%d, %t)", v, ok)
+		}
+		if _, ok := sub.Ceiling(35); ok {
+			t.Error("Expected Ceiling above the view to fail")
+		}
+		if _, ok := set.SubSet(41, 49).First(); ok {
+			t.Error("Expected First of an empty view to fail")
+		}
+		if _, ok := set.SubSet(41, 49).Last(); ok {
+			t.Error("Expected Last of an empty view to fail")
+		}
+	})
+
+	t.Run("Add outside the view panics", func(t *testing.T) {
+		set := New([]int{1, 2, 3})
+		r := recoverPanic(func() { set.HeadSet(2).Add(5) })
+		if r == nil || !strings.Contains(fmt.Sprint(r), "outside view range") {
+			t.Errorf("Expected out of range panic, got %v", r)
+		}
+		if set.Contains(5) {
+			t.Error("Expected rejected element not to be added")
+		}
+	})
+
+	t.Run("Clone of a view is independent", func(t *testing.T) {
+		set := New([]int{1, 2, 3, 4})
+		clone := set.TailSet(3).Clone()
+		clone.Add(1)
+		if !reflect.DeepEqual(clone.ToSlice(), []int{1, 3, 4}) {
+			t.Errorf("Expected [1 3 4], got %v", clone.ToSlice())
+		}
+		if set.Size() != 4 {
+			t.Errorf("Expected original size 4, got %d", set.Size())
+		}
+	})
+}
+
+func TestTreeSetIterators(t *testing.T) {
+	set := New([]int{3, 1, 2})
+	if got := slices.Collect(set.All()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+	if got := slices.Collect(set.Backward()); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", got)
+	}
+
+	for _, seq := range []func(func(int) bool){set.All(), set.Backward(), set.TailSet(1).All(), set.TailSet(1).Backward()} {
+		count := 0
+		for range seq {
+			count++
+			break
+		}
+		if count != 1 {
+			t.Errorf("Expected early termination after 1 element, got %d", count)
+		}
+	}
+}
+
+func TestTreeSetEquals(t *testing.T) {
+	a := New([]int{1, 2, 3})
+	if !a.Equals(New([]int{3, 2, 1})) {
+		t.Error("Expected sets with the same elements to be equal")
+	}
+	if a.Equals(New([]int{1, 2})) || a.Equals(New([]int{1, 2, 4})) {
+		t.Error("Expected sets with different elements not to be equal")
+	}
+	if !New([]int{0, 2, 3, 9}).SubSet(1, 5).Equals(New([]int{2, 3})) {
+		t.Error("Expected a view to equal a set with its elements")
+	}
+}
+
+func TestTreeSetAlgebra(t *testing.T) {
+	a := New([]int{1, 2, 3, 4})
+	b := New([]int{3, 4, 5})
+	c := New([]int{4, 6})
+
+	tests := []struct {
+		name     string
+		result   *TreeSet[int]
+		expected []int
+	}{
+		{"Union", a.Union(b, c), []int{1, 2, 3, 4, 5, 6}},
+		{"Union without arguments", a.Union(), []int{1, 2, 3, 4}},
+		{"Intersection", a.Intersection(b, c), []int{4}},
+		{"Intersection without arguments", a.Intersection(), []int{1, 2, 3, 4}},
+		{"Difference", a.Difference(b), []int{1, 2}},
+		{"Difference of several", a.Difference(b, New([]int{1})), []int{2}},
+		{"SymmetricDifference", a.SymmetricDifference(b), []int{1, 2, 5}},
+		{"Union of a view", a.HeadSet(3).Union(c), []int{1, 2, 4, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.result.ToSlice(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+
+	if !reflect.DeepEqual(a.ToSlice(), []int{1, 2, 3, 4}) {
+		t.Errorf("Expected operands to be unmodified, got %v", a.ToSlice())
+	}
+
+	t.Run("Subset, superset and disjoint", func(t *testing.T) {
+		if !New([]int{3, 4}).IsSubsetOf(a) || b.IsSubsetOf(a) {
+			t.Error("Unexpected IsSubsetOf result")
+		}
+		if !a.IsSupersetOf(New([]int{1, 4})) || a.IsSupersetOf(b) {
+			t.Error("Unexpected IsSupersetOf result")
+		}
+		if !New[int](nil).IsSubsetOf(a) {
+			t.Error("Expected the empty set to be a subset")
+		}
+		if !a.IsDisjoint(New([]int{7, 8})) || a.IsDisjoint(c) || c.IsDisjoint(a) {
+			t.Error("Unexpected IsDisjoint result")
+		}
+	})
+
+	t.Run("In-place operations", func(t *testing.T) {
+		s := New([]int{1, 2})
+		s.UnionWith(New([]int{3}), New([]int{4}))
+		if !reflect.DeepEqual(s.ToSlice(), []int{1, 2, 3, 4}) {
+			t.Errorf("Expected [1 2 3 4], got %v", s.ToSlice())
+		}
+		s.UnionWith(s)
+		if s.Size() != 4 {
+			t.Errorf("Expected self union to leave size 4, got %d", s.Size())
+		}
+
+		s.IntersectWith(New([]int{2, 3, 4, 5}))
+		if !reflect.DeepEqual(s.ToSlice(), []int{2, 3, 4}) {
+			t.Errorf("Expected [2 3 4], got %v", s.ToSlice())
+		}
+
+		s.ExceptWith(New([]int{3}), New([]int{2, 4, 6, 8, 10}))
+		if !reflect.DeepEqual(s.ToSlice(), []int{}) {
+			t.Errorf("Expected empty set, got %v", s.ToSlice())
+		}
+
+		s = New([]int{1, 2, 3, 4})
+		s.HeadSet(3).ExceptWith(s)
+		if !reflect.DeepEqual(s.ToSlice(), []int{3, 4}) {
+			t.Errorf("Expected [3 4], got %v", s.ToSlice())
+		}
+	})
+}
+
+func TestTreeSetRandomizedViews(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	set := New[int](nil)
+	model := map[int]bool{}
+	for i := 0; i < 2000; i++ {
+		v := rng.Intn(300)
+		if rng.Intn(4) == 0 {
+			set.Remove(v)
+			delete(model, v)
+		} else {
+			set.Add(v)
+			model[v] = true
+		}
+	}
+
+	for i := 0; i < 200; i++ {
+		from, to := rng.Intn(320)-10, rng.Intn(320)-10
+		var expected []int
+		for v := range model {
+			if v >= from && v < to {
+				expected = append(expected, v)
+			}
+		}
+		slices.Sort(expected)
+
+		view := set.SubSet(from, to)
+		got := view.ToSlice()
+		if !slices.Equal(got, expected) {
+			t.Fatalf("SubSet(%d, %d): expected %v, got %v", from, to, expected, got)
+		}
+		if view.Size() != len(expected) {
+			t.Fatalf("SubSet(%d, %d): expected size %d, got %d", from, to, len(expected), view.Size())
+		}
+		backward := slices.Collect(view.Backward())
+		slices.Reverse(backward)
+		if !slices.Equal(backward, expected) {
+			t.Fatalf("SubSet(%d, %d): expected Backward to reverse %v, got %v", from, to, expected, backward)
+		}
+
+		var head, tail []int
+		for v := range model {
+			if v < to {
+				head = append(head, v)
+			}
+			if v >= from {
+				tail = append(tail, v)
+			}
+		}
+		slices.Sort(head)
+		slices.Sort(tail)
+		if got := set.HeadSet(to).ToSlice(); !slices.Equal(got, head) {
+			t.Fatalf("HeadSet(%d): expected %v, got %v", to, head, got)
+		}
+		if got := set.TailSet(from).ToSlice(); !slices.Equal(got, tail) {
+			t.Fatalf("TailSet(%d): expected %v, got %v", from, tail, got)
+		}
+	}
+}
+
+// Benchmark tests
+func BenchmarkTreeSetAdd(b *testing.B) {
+	set := New[int](nil)
+	rng := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		set.Add(rng.Int())
+	}
+}
+
+func BenchmarkTreeSetContains(b *testing.B) {
+	set := New[int](nil)
+	for i := 0; i < 1<<16; i++ {
+		set.Add(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		set.Contains(i & (1<<16 - 1))
+	}
+}
+
+func BenchmarkTreeSetToSlice(b *testing.B) {
+	set := New[int](nil)
+	for i := 0; i < 1000; i++ {
+		set.Add(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		set.ToSlice()
+	}
+}
+
+func BenchmarkTreeSetSubSetSize(b *testing.B) {
+	set := New[int](nil)
+	for i := 0; i < 1<<16; i++ {
+		set.Add(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		from := i & (1<<15 - 1)
+		set.SubSet(from, from+1<<15).Size()
+	}
+}
+
+func BenchmarkTreeSetSubSetAll(b *testing.B) {
+	set := New[int](nil)
+	for i := 0; i < 1<<16; i++ {
+		set.Add(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		from := i & (1<<15 - 1)
+		for range set.SubSet(from, from+100).All() {
+		}
+	}
+}
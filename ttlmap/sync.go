@@ -0,0 +1,245 @@
+package ttlmap
+
+import (
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/thefrost13/gollections/orderedhashmap"
+)
+
+// SyncMap is a Map that is safe for concurrent use by multiple goroutines.
+// Reads take a shared lock and writes take an exclusive lock on a sync.RWMutex,
+// and compound operations such as GetOrSet are performed atomically.
+// StartJanitor runs Sweep periodically in a background goroutine.
+//
+// Type parameters:
+//   - K: the key type, must be comparable
+//   - V: the value type, can be any type
+type SyncMap[K comparable, V any] struct {
+	mu sync.RWMutex // guards m
+	m  *Map[K, V]   // the wrapped map
+}
+
+// NewSync creates and returns a new SyncMap wrapping the given map.
+// The caller must not use m directly afterwards. The map must not be nil.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - m: the Map to guard
+//
+// Returns:
+//   - a new SyncMap guarding m
+//
+// Example:
+//
+//	sessions := NewSync(New[string, *Session](30 * time.Minute))
+//	stop := sessions.StartJanitor(time.Minute)
+//	defer stop()
+func NewSync[K comparable, V any](m *Map[K, V]) *SyncMap[K, V] {
+	return &SyncMap[K, V]{m: m}
+}
+
+// Set inserts or updates a key-value pair using the map's default TTL.
+// Time complexity: O(log n).
+func (s *SyncMap[K, V]) Set(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Set(key, value)
+}
+
+// SetWithTTL inserts or updates a key-value pair that expires ttl after now.
+// Time complexity: O(log n).
+func (s *SyncMap[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.SetWithTTL(key, value, ttl)
+}
+
+// Get retrieves the value associated with the given key if it has not expired.
+// Time complexity: O(1) average case.
+func (s *SyncMap[K, V]) Get(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Get(key)
+}
+
+// GetWithExpiry retrieves the value associated with the given key and its deadline if it has not expired.
+// Time complexity: O(1) average case.
+func (s *SyncMap[K, V]) GetWithExpiry(key K) (V, time.Time, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.GetWithExpiry(key)
+}
+
+// GetOrSet returns the existing value for key if it is live.
+// Otherwise it stores value with the given TTL and returns it.
+// The lookup and the insertion happen atomically.
+// Time complexity: O(log n).
+//
+// Parameters:
+//   - key: the key to look up or insert
+//   - value: the value to store if key is missing or expired
+//   - ttl: the TTL of the stored value, <= 0 for no expiry
+//
+// Returns:
+//   - actual: the existing value if key was live, otherwise value
+//   - loaded: true if the value was already present, false if it was stored
+//
+// Example:
+//
+//	token, loaded := tokens.GetOrSet(user, issueToken(user), 15*time.Minute)
+func (s *SyncMap[K, V]) GetOrSet(key K, value V, ttl time.Duration) (actual V, loaded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.m.Get(key); ok {
+		return existing, true
+	}
+	s.m.SetWithTTL(key, value, ttl)
+	return value, false
+}
+
+// Contains reports whether the map holds a live entry for key.
+// Time complexity: O(1) average case.
+func (s *SyncMap[K, V]) Contains(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Contains(key)
+}
+
+// Delete removes the entry for key, reporting whether a live entry was removed.
+// Time complexity: O(log n).
+func (s *SyncMap[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Delete(key)
+}
+
+// Sweep removes every expired entry and returns how many were removed.
+// Time complexity: O(k log n) where k is the number of expired entries.
+func (s *SyncMap[K, V]) Sweep() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Sweep()
+}
+
+// NextExpiry returns the earliest deadline among entries that have a TTL.
+// Time complexity: O(1).
+func (s *SyncMap[K, V]) NextExpiry() (time.Time, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.NextExpiry()
+}
+
+// StartJanitor starts a goroutine that calls Sweep every interval and returns a function that stops it.
+// The stop function waits for the goroutine to exit and may be called more than once.
+// The interval is measured in real time, while expiry is still decided by the map's clock.
+//
+// Parameters:
+//   - interval: the time between sweeps, must be positive
+//
+// Returns:
+//   - a function that stops the janitor
+//
+// Example:
+//
+//	stop := sessions.StartJanitor(time.Minute)
+//	defer stop()
+func (s *SyncMap[K, V]) StartJanitor(interval time.Duration) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.Sweep()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+		<-exited
+	}
+}
+
+// Size returns the number of stored entries, including expired entries not yet swept.
+// Time complexity: O(1).
+func (s *SyncMap[K, V]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Size()
+}
+
+// IsEmpty returns true if the map stores no entries, expired or not.
+// Time complexity: O(1).
+func (s *SyncMap[K, V]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.IsEmpty()
+}
+
+// Keys returns the keys of all live entries in insertion order.
+// Time complexity: O(n).
+func (s *SyncMap[K, V]) Keys() []K {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Keys()
+}
+
+// Values returns the values of all live entries in insertion order.
+// Time complexity: O(n).
+func (s *SyncMap[K, V]) Values() []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Values()
+}
+
+// ToSlice returns all live key-value pairs in insertion order.
+// Time complexity: O(n).
+func (s *SyncMap[K, V]) ToSlice() []orderedhashmap.KVPair[K, V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.ToSlice()
+}
+
+// All returns an iterator over a snapshot of the live key-value pairs in insertion order.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the map.
+// Time complexity: O(n) time and O(n) space.
+func (s *SyncMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, pair := range s.ToSlice() {
+			if !yield(pair.Key, pair.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over a snapshot of the live key-value pairs in reverse insertion order.
+// The snapshot is taken when iteration starts, so the loop body may freely modify the map.
+// Time complexity: O(n) time and O(n) space.
+func (s *SyncMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		pairs := s.ToSlice()
+		for i := len(pairs) - 1; i >= 0; i-- {
+			if !yield(pairs[i].Key, pairs[i].Value) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all entries from the map.
+// Time complexity: O(n).
+func (s *SyncMap[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Clear()
+}
@@ -0,0 +1,101 @@
+package ttlmap
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thefrost13/gollections/clock"
+)
+
+func TestSyncMapOperations(t *testing.T) {
+	fake := clock.NewFake(epoch)
+	m := NewSync(NewWithClock[string, int](time.Minute, fake))
+
+	m.Set("a", 1)
+	if actual, loaded := m.GetOrSet("a", 100, time.Minute); !loaded || actual != 1 {
+		t.Errorf("Expected (1, true), got (%d, %t)", actual, loaded)
+	}
+	if actual, loaded := m.GetOrSet("b", 2, 0); loaded || actual != 2 {
+		t.Errorf("Expected (2, false), got (%d, %t)", actual, loaded)
+	}
+	fake.Advance(time.Minute)
+	if actual, loaded := m.GetOrSet("a", 3, time.Minute); loaded || actual != 3 {
+		t.Errorf("Expected expired key to be replaced, got (%d, %t)", actual, loaded)
+	}
+	if _, at, ok := m.GetWithExpiry("a"); !ok || !at.Equal(epoch.Add(2*time.Minute)) {
+		t.Errorf("Expected deadline %v, got %v", epoch.Add(2*time.Minute), at)
+	}
+
+	for k := range m.All() {
+		m.Delete(k) // modifying during iteration must not deadlock
+	}
+	if !m.IsEmpty() {
+		t.Errorf("Expected empty map, got size %d", m.Size())
+	}
+}
+
+func TestSyncMapJanitor(t *testing.T) {
+	fake := clock.NewFake(epoch)
+	m := NewSync(NewWithClock[string, int](time.Second, fake))
+	for i := 0; i < 10; i++ {
+		m.Set(strconv.Itoa(i), i)
+	}
+	m.SetWithTTL("forever", 1, 0)
+
+	stop := m.StartJanitor(time.Millisecond)
+	defer stop()
+
+	fake.Advance(time.Second)
+	deadline := time.Now().Add(5 * time.Second)
+	for m.Size() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected janitor to sweep expired entries, size is %d", m.Size())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	stop()
+	stop() // stopping twice is allowed
+	m.SetWithTTL("late", 1, time.Second)
+	fake.Advance(time.Second)
+	time.Sleep(5 * time.Millisecond)
+	if m.Size() != 2 {
+		t.Errorf("Expected stopped janitor not to sweep, got size %d", m.Size())
+	}
+}
+
+func TestSyncMapConcurrent(t *testing.T) {
+	const goroutines = 32
+	const ops = 500
+
+	fake := clock.NewFake(epoch)
+	m := NewSync(NewWithClock[int, int](10*time.Millisecond, fake))
+	stop := m.StartJanitor(time.Millisecond)
+	defer stop()
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < ops; i++ {
+				key := g*ops + i
+				m.Set(key, i)
+				m.Get(key)
+				if i%10 == 0 {
+					fake.Advance(time.Millisecond)
+					m.Keys()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	fake.Advance(time.Second)
+	m.Sweep()
+	if m.Size() != 0 {
+		t.Errorf("Expected every entry to be swept, got size %d", m.Size())
+	}
+}
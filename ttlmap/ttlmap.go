@@ -0,0 +1,365 @@
+// Package ttlmap provides an insertion-ordered hash map whose entries expire after a time-to-live.
+// It is built on orderedhashmap.OrderedHashMap for ordered storage and a priorityqueue.Queue
+// keyed by deadline so that expired entries can be swept in expiry order.
+package ttlmap
+
+import (
+	"iter"
+	"time"
+
+	"github.com/thefrost13/gollections/clock"
+	"github.com/thefrost13/gollections/orderedhashmap"
+	"github.com/thefrost13/gollections/priorityqueue"
+)
+
+// entry is the value stored in the ordered map for each key.
+type entry[K comparable, V any] struct {
+	value     V                                  // the stored value
+	expiresAt time.Time                          // deadline of the entry, zero if it never expires
+	handle    priorityqueue.Handle[K, time.Time] // position in the expiry queue, zero if it never expires
+}
+
+// Map is a generic hash map that keeps insertion order and hides entries once their TTL has passed.
+// Expired entries are invisible to every read but keep occupying memory until Sweep removes them
+// or a new Set replaces them; SyncMap.StartJanitor can call Sweep periodically.
+// Time is read from a clock.Clock so expiry can be driven manually in tests.
+// A Map must be created with New or NewWithClock.
+//
+// Type parameters:
+//   - K: the key type, must be comparable
+//   - V: the value type, can be any type
+type Map[K comparable, V any] struct {
+	items      *orderedhashmap.OrderedHashMap[K, *entry[K, V]] // entries in insertion order
+	expiry     *priorityqueue.Queue[K, time.Time]              // keys with a TTL, earliest deadline first
+	clock      clock.Clock                                     // source of the current time
+	defaultTTL time.Duration                                   // TTL used by Set, <= 0 means no expiry
+}
+
+// New creates and returns a new empty Map that reads time from the system clock.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - defaultTTL: the TTL applied by Set, <= 0 for entries that never expire
+//
+// Returns:
+//   - a new empty Map
+//
+// Example:
+//
+//	tokens := New[string, string](15 * time.Minute)
+//	tokens.Set("alice", "token-1")                       // expires in 15 minutes
+//	tokens.SetWithTTL("bob", "token-2", time.Minute)     // expires in 1 minute
+func New[K comparable, V any](defaultTTL time.Duration) *Map[K, V] {
+	return NewWithClock[K, V](defaultTTL, clock.System())
+}
+
+// NewWithClock creates and returns a new empty Map that reads time from the given clock.
+// If c is nil, the system clock is used.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - defaultTTL: the TTL applied by Set, <= 0 for entries that never expire
+//   - c: the clock used to decide expiry, can be nil
+//
+// Returns:
+//   - a new empty Map
+//
+// Example:
+//
+//	fake := clock.NewFake(time.Now())
+//	m := NewWithClock[string, int](time.Minute, fake)
+//	m.Set("a", 1)
+//	fake.Advance(time.Minute)  // "a" is now expired
+func NewWithClock[K comparable, V any](defaultTTL time.Duration, c clock.Clock) *Map[K, V] {
+	if c == nil {
+		c = clock.System()
+	}
+	return &Map[K, V]{
+		items:      orderedhashmap.New[K, *entry[K, V]](),
+		expiry:     priorityqueue.NewLess[K](time.Time.Before, priorityqueue.WithStable()),
+		clock:      c,
+		defaultTTL: defaultTTL,
+	}
+}
+
+// Set inserts or updates a key-value pair using the map's default TTL.
+// Time complexity: O(log n) where n is the number of entries with a TTL.
+//
+// Parameters:
+//   - key: the key to insert or update
+//   - value: the value to associate with the key
+func (m *Map[K, V]) Set(key K, value V) {
+	m.SetWithTTL(key, value, m.defaultTTL)
+}
+
+// SetWithTTL inserts or updates a key-value pair that expires ttl after now.
+// A ttl <= 0 stores an entry that never expires.
+// Updating a live key replaces its value and deadline but keeps its position in the order;
+// setting a key whose entry has already expired inserts it afresh at the end.
+// Time complexity: O(log n) where n is the number of entries with a TTL.
+//
+// Parameters:
+//   - key: the key to insert or update
+//   - value: the value to associate with the key
+//   - ttl: how long the entry stays visible, <= 0 for no expiry
+//
+// Example:
+//
+//	m.SetWithTTL("session:42", session, 30*time.Minute)
+func (m *Map[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	now := m.clock.Now()
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = now.Add(ttl)
+	}
+
+	e, exists := m.items.Get(key)
+	if exists && e.expired(now) {
+		m.remove(key, e)
+		exists = false
+	}
+	if !exists {
+		e = &entry[K, V]{value: value, expiresAt: expiresAt}
+		if ttl > 0 {
+			e.handle = m.expiry.EnqueueHandle(key, expiresAt)
+		}
+		m.items.Set(key, e)
+		return
+	}
+
+	e.value = value
+	e.expiresAt = expiresAt
+	switch {
+	case ttl <= 0:
+		m.expiry.Remove(e.handle)
+		e.handle = priorityqueue.Handle[K, time.Time]{}
+	case !m.expiry.UpdatePriority(e.handle, expiresAt):
+		e.handle = m.expiry.EnqueueHandle(key, expiresAt)
+	}
+}
+
+// Get retrieves the value associated with the given key if it has not expired.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - value: the value associated with the key, or zero value if key is missing or expired
+//   - ok: true if a live entry was found, false otherwise
+//
+// Example:
+//
+//	if token, ok := tokens.Get("alice"); ok {
+//	    authorize(token)
+//	}
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	value, _, ok := m.GetWithExpiry(key)
+	return value, ok
+}
+
+// GetWithExpiry retrieves the value associated with the given key and its deadline if it has not expired.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - value: the value associated with the key, or zero value if key is missing or expired
+//   - expiresAt: the time the entry expires, or the zero time if it never expires
+//   - ok: true if a live entry was found, false otherwise
+func (m *Map[K, V]) GetWithExpiry(key K) (V, time.Time, bool) {
+	e, exists := m.items.Get(key)
+	if !exists || e.expired(m.clock.Now()) {
+		var zero V
+		return zero, time.Time{}, false
+	}
+	return e.value, e.expiresAt, true
+}
+
+// Contains reports whether the map holds a live entry for key.
+// Time complexity: O(1) average case.
+//
+// Parameters:
+//   - key: the key to look up
+//
+// Returns:
+//   - true if a live entry exists for key, false otherwise
+func (m *Map[K, V]) Contains(key K) bool {
+	_, _, ok := m.GetWithExpiry(key)
+	return ok
+}
+
+// Delete removes the entry for key, whether or not it has expired.
+// Time complexity: O(log n) where n is the number of entries with a TTL.
+//
+// Parameters:
+//   - key: the key to remove
+//
+// Returns:
+//   - true if a live entry was removed, false if key was missing or already expired
+func (m *Map[K, V]) Delete(key K) bool {
+	e, exists := m.items.Get(key)
+	if !exists {
+		return false
+	}
+	live := !e.expired(m.clock.Now())
+	m.remove(key, e)
+	return live
+}
+
+// Sweep removes every expired entry, earliest deadline first, and returns how many were removed.
+// Entries sharing a deadline are removed in insertion order.
+// Time complexity: O(k log n) where k is the number of expired entries.
+//
+// Returns:
+//   - the number of entries removed
+//
+// Example:
+//
+//	removed := m.Sweep()
+//	fmt.Printf("Dropped %d expired tokens\n", removed)
+func (m *Map[K, V]) Sweep() int {
+	now := m.clock.Now()
+	removed := 0
+	for {
+		key, ok := m.expiry.TryPeek()
+		if !ok {
+			return removed
+		}
+		e, _ := m.items.Get(key)
+		if !e.expired(now) {
+			return removed
+		}
+		m.expiry.Dequeue()
+		m.items.Delete(key)
+		removed++
+	}
+}
+
+// NextExpiry returns the earliest deadline among entries that have a TTL,
+// including entries that have already expired but not been swept.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the earliest deadline, or the zero time if no entry has a TTL
+//   - true if some entry has a TTL, false otherwise
+func (m *Map[K, V]) NextExpiry() (time.Time, bool) {
+	key, ok := m.expiry.TryPeek()
+	if !ok {
+		return time.Time{}, false
+	}
+	e, _ := m.items.Get(key)
+	return e.expiresAt, true
+}
+
+// Size returns the number of entries stored in the map, including expired entries
+// that have not been removed yet. Call Sweep first to count only live entries.
+// Time complexity: O(1).
+//
+// Returns:
+//   - the number of stored entries
+func (m *Map[K, V]) Size() int {
+	return m.items.Size()
+}
+
+// IsEmpty returns true if the map stores no entries, expired or not.
+// Time complexity: O(1).
+//
+// Returns:
+//   - true if the map is empty, false otherwise
+func (m *Map[K, V]) IsEmpty() bool {
+	return m.items.IsEmpty()
+}
+
+// Keys returns the keys of all live entries in insertion order.
+// Time complexity: O(n) where n is the number of stored entries.
+//
+// Returns:
+//   - a slice of live keys in insertion order
+func (m *Map[K, V]) Keys() []K {
+	keys := make([]K, 0, m.items.Size())
+	for key := range m.All() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns the values of all live entries in insertion order.
+// Time complexity: O(n) where n is the number of stored entries.
+//
+// Returns:
+//   - a slice of live values in insertion order
+func (m *Map[K, V]) Values() []V {
+	values := make([]V, 0, m.items.Size())
+	for _, value := range m.All() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// ToSlice returns all live key-value pairs in insertion order.
+// Time complexity: O(n) where n is the number of stored entries.
+//
+// Returns:
+//   - a slice of live key-value pairs in insertion order
+func (m *Map[K, V]) ToSlice() []orderedhashmap.KVPair[K, V] {
+	pairs := make([]orderedhashmap.KVPair[K, V], 0, m.items.Size())
+	for key, value := range m.All() {
+		pairs = append(pairs, orderedhashmap.KVPair[K, V]{Key: key, Value: value})
+	}
+	return pairs
+}
+
+// All returns an iterator over the live key-value pairs in insertion order.
+// Expiry is evaluated once, when iteration starts. The map must not be modified during iteration.
+// Time complexity: O(n) for a full iteration.
+//
+// Returns:
+//   - an iter.Seq2 yielding each live key and its value in insertion order
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return m.live(m.items.All())
+}
+
+// Backward returns an iterator over the live key-value pairs in reverse insertion order.
+// Expiry is evaluated once, when iteration starts. The map must not be modified during iteration.
+// Time complexity: O(n) for a full iteration.
+//
+// Returns:
+//   - an iter.Seq2 yielding each live key and its value, most recently inserted first
+func (m *Map[K, V]) Backward() iter.Seq2[K, V] {
+	return m.live(m.items.Backward())
+}
+
+// Clear removes all entries from the map.
+// Time complexity: O(n) where n is the number of entries with a TTL.
+func (m *Map[K, V]) Clear() {
+	m.items = orderedhashmap.New[K, *entry[K, V]]()
+	m.expiry.Clear()
+}
+
+// live filters seq down to the entries that have not expired at the time iteration starts.
+func (m *Map[K, V]) live(seq iter.Seq2[K, *entry[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		now := m.clock.Now()
+		for key, e := range seq {
+			if e.expired(now) {
+				continue
+			}
+			if !yield(key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// remove deletes key from both the ordered map and the expiry queue.
+func (m *Map[K, V]) remove(key K, e *entry[K, V]) {
+	m.items.Delete(key)
+	m.expiry.Remove(e.handle)
+}
+
+// expired reports whether the entry's deadline is at or before now.
+func (e *entry[K, V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
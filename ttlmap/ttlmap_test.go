@@ -0,0 +1,279 @@
+package ttlmap
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/thefrost13/gollections/clock"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestMap(defaultTTL time.Duration) (*Map[string, int], *clock.Fake) {
+	fake := clock.NewFake(epoch)
+	return NewWithClock[string, int](defaultTTL, fake), fake
+}
+
+func TestNew(t *testing.T) {
+	t.Run("New uses the system clock", func(t *testing.T) {
+		m := New[string, int](time.Hour)
+		if m == nil {
+			t.Fatal("Expected map to be initialized, got nil")
+		}
+		m.Set("a", 1)
+		if v, ok := m.Get("a"); !ok || v != 1 {
+			t.Errorf("Expected (1, true), got (%d, %v)", v, ok)
+		}
+	})
+
+	t.Run("NewWithClock with nil clock", func(t *testing.T) {
+		m := NewWithClock[string, int](0, nil)
+		m.Set("a", 1)
+		if !m.Contains("a") {
+			t.Error("Expected a to be present")
+		}
+	})
+}
+
+func TestMapExpiry(t *testing.T) {
+	t.Run("Default TTL", func(t *testing.T) {
+		m, fake := newTestMap(time.Minute)
+		m.Set("a", 1)
+		fake.Advance(59 * time.Second)
+		if v, ok := m.Get("a"); !ok || v != 1 {
+			t.Errorf("Expected (1, true) before expiry, got (%d, %v)", v, ok)
+		}
+		fake.Advance(time.Second)
+		if v, ok := m.Get("a"); ok || v != 0 {
+			t.Errorf("Expected (0, false) at expiry, got (%d, %v)", v, ok)
+		}
+		if m.Contains("a") {
+			t.Error("Expected Contains to hide expired entry")
+		}
+	})
+
+	t.Run("Zero TTL never expires", func(t *testing.T) {
+		m, fake := newTestMap(0)
+		m.Set("a", 1)
+		m.SetWithTTL("b", 2, -time.Second)
+		fake.Advance(24 * 365 * time.Hour)
+		if !m.Contains("a") || !m.Contains("b") {
+			t.Error("Expected entries without TTL to stay visible")
+		}
+		if _, ok := m.NextExpiry(); ok {
+			t.Error("Expected no expiry deadline")
+		}
+	})
+
+	t.Run("Per-entry TTL", func(t *testing.T) {
+		m, fake := newTestMap(time.Hour)
+		m.SetWithTTL("short", 1, time.Second)
+		m.Set("long", 2)
+		fake.Advance(time.Second)
+		if m.Contains("short") {
+			t.Error("Expected short to be expired")
+		}
+		if !m.Contains("long") {
+			t.Error("Expected long to be live")
+		}
+	})
+
+	t.Run("GetWithExpiry", func(t *testing.T) {
+		m, _ := newTestMap(time.Minute)
+		m.Set("a", 1)
+		m.SetWithTTL("b", 2, 0)
+		if _, at, ok := m.GetWithExpiry("a"); !ok || !at.Equal(epoch.Add(time.Minute)) {
+			t.Errorf("Expected deadline %v, got %v", epoch.Add(time.Minute), at)
+		}
+		if _, at, ok := m.GetWithExpiry("b"); !ok || !at.IsZero() {
+			t.Errorf("Expected zero deadline, got %v", at)
+		}
+	})
+
+	t.Run("Expired entries are hidden from Keys, Values and iterators", func(t *testing.T) {
+		m, fake := newTestMap(time.Minute)
+		m.Set("a", 1)
+		m.SetWithTTL("b", 2, 10*time.Second)
+		m.Set("c", 3)
+		m.SetWithTTL("d", 4, 0)
+		fake.Advance(30 * time.Second)
+
+		if !reflect.DeepEqual(m.Keys(), []string{"a", "c", "d"}) {
+			t.Errorf("Expected keys [a c d], got %v", m.Keys())
+		}
+		if !reflect.DeepEqual(m.Values(), []int{1, 3, 4}) {
+			t.Errorf("Expected values [1 3 4], got %v", m.Values())
+		}
+		if len(m.ToSlice()) != 3 || m.ToSlice()[1].Key != "c" {
+			t.Errorf("Unexpected ToSlice %v", m.ToSlice())
+		}
+		var backward []string
+		for k := range m.Backward() {
+			backward = append(backward, k)
+		}
+		if !reflect.DeepEqual(backward, []string{"d", "c", "a"}) {
+			t.Errorf("Expected backward keys [d c a], got %v", backward)
+		}
+		if m.Size() != 4 {
+			t.Errorf("Expected size 4 before Sweep, got %d", m.Size())
+		}
+	})
+}
+
+func TestMapUpdate(t *testing.T) {
+	t.Run("Updating a live key keeps its position and resets its TTL", func(t *testing.T) {
+		m, fake := newTestMap(time.Minute)
+		m.Set("a", 1)
+		m.Set("b", 2)
+		fake.Advance(50 * time.Second)
+		m.Set("a", 10)
+		fake.Advance(30 * time.Second)
+		if !reflect.DeepEqual(m.Keys(), []string{"a"}) {
+			t.Errorf("Expected keys [a], got %v", m.Keys())
+		}
+		if v, _ := m.Get("a"); v != 10 {
+			t.Errorf("Expected 10, got %d", v)
+		}
+	})
+
+	t.Run("Updating an expired key inserts it afresh", func(t *testing.T) {
+		m, fake := newTestMap(time.Minute)
+		m.Set("a", 1)
+		m.SetWithTTL("b", 2, 0)
+		fake.Advance(time.Minute)
+		m.Set("a", 3)
+		if !reflect.DeepEqual(m.Keys(), []string{"b", "a"}) {
+			t.Errorf("Expected keys [b a], got %v", m.Keys())
+		}
+		if v, _ := m.Get("a"); v != 3 {
+			t.Errorf("Expected 3, got %d", v)
+		}
+		if m.expiry.Size() != 1 {
+			t.Errorf("Expected 1 deadline in the expiry queue, got %d", m.expiry.Size())
+		}
+	})
+
+	t.Run("Switching between TTL and no TTL", func(t *testing.T) {
+		m, fake := newTestMap(time.Minute)
+		m.Set("a", 1)
+		m.SetWithTTL("a", 2, 0)
+		if m.expiry.Size() != 0 {
+			t.Errorf("Expected empty expiry queue, got %d", m.expiry.Size())
+		}
+		fake.Advance(time.Hour)
+		if !m.Contains("a") {
+			t.Error("Expected a to be live after removing its TTL")
+		}
+		m.SetWithTTL("a", 3, time.Second)
+		fake.Advance(time.Second)
+		if m.Contains("a") {
+			t.Error("Expected a to expire after adding a TTL")
+		}
+	})
+}
+
+func TestMapSweep(t *testing.T) {
+	t.Run("Sweep removes only expired entries", func(t *testing.T) {
+		m, fake := newTestMap(time.Minute)
+		for i := 0; i < 5; i++ {
+			m.SetWithTTL(strconv.Itoa(i), i, time.Duration(i+1)*time.Second)
+		}
+		m.SetWithTTL("forever", 99, 0)
+		fake.Advance(3 * time.Second)
+		if n := m.Sweep(); n != 3 {
+			t.Errorf("Expected 3 entries swept, got %d", n)
+		}
+		if m.Size() != 3 {
+			t.Errorf("Expected size 3, got %d", m.Size())
+		}
+		if !reflect.DeepEqual(m.Keys(), []string{"3", "4", "forever"}) {
+			t.Errorf("Expected keys [3 4 forever], got %v", m.Keys())
+		}
+		if at, ok := m.NextExpiry(); !ok || !at.Equal(epoch.Add(4*time.Second)) {
+			t.Errorf("Expected next expiry %v, got %v", epoch.Add(4*time.Second), at)
+		}
+		if n := m.Sweep(); n != 0 {
+			t.Errorf("Expected nothing to sweep, got %d", n)
+		}
+	})
+
+	t.Run("Sweep follows expiry order, not insertion order", func(t *testing.T) {
+		m, fake := newTestMap(0)
+		m.SetWithTTL("late", 1, 3*time.Second)
+		m.SetWithTTL("early", 2, time.Second)
+		m.SetWithTTL("middle", 3, 2*time.Second)
+		fake.Advance(2 * time.Second)
+		if n := m.Sweep(); n != 2 {
+			t.Errorf("Expected 2 entries swept, got %d", n)
+		}
+		if !reflect.DeepEqual(m.Keys(), []string{"late"}) {
+			t.Errorf("Expected keys [late], got %v", m.Keys())
+		}
+	})
+
+	t.Run("Delete removes from the expiry queue", func(t *testing.T) {
+		m, fake := newTestMap(time.Minute)
+		m.Set("a", 1)
+		m.Set("b", 2)
+		if !m.Delete("a") {
+			t.Error("Expected Delete to report a live entry")
+		}
+		if m.expiry.Size() != 1 {
+			t.Errorf("Expected 1 deadline left, got %d", m.expiry.Size())
+		}
+		fake.Advance(time.Minute)
+		if m.Delete("b") {
+			t.Error("Expected Delete of an expired entry to report false")
+		}
+		if m.Delete("missing") {
+			t.Error("Expected Delete of a missing key to report false")
+		}
+		if !m.IsEmpty() || m.expiry.Size() != 0 {
+			t.Error("Expected map and expiry queue to be empty")
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		m, _ := newTestMap(time.Minute)
+		m.Set("a", 1)
+		m.SetWithTTL("b", 2, 0)
+		m.Clear()
+		if !m.IsEmpty() || m.expiry.Size() != 0 {
+			t.Error("Expected map to be empty after Clear")
+		}
+		m.Set("a", 3)
+		if v, ok := m.Get("a"); !ok || v != 3 {
+			t.Errorf("Expected (3, true), got (%d, %v)", v, ok)
+		}
+	})
+}
+
+// Benchmark tests
+func BenchmarkMapSet(b *testing.B) {
+	m, _ := newTestMap(time.Minute)
+	keys := make([]string, 1024)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Set(keys[i&1023], i)
+	}
+}
+
+func BenchmarkMapGet(b *testing.B) {
+	m, _ := newTestMap(time.Minute)
+	keys := make([]string, 1024)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+		m.Set(keys[i], i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		m.Get(keys[i&1023])
+	}
+}
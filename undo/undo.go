@@ -0,0 +1,418 @@
+// Package undo provides an undo/redo history of reversible commands, with transactions
+// that group several commands into one step, a bounded depth and save points.
+package undo
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+
+	"github.com/thefrost13/gollections/deque"
+	"github.com/thefrost13/gollections/errs"
+	"github.com/thefrost13/gollections/stack"
+)
+
+var (
+	// ErrInTransaction is reported by Undo, Redo, Restore and Clear while a transaction is open.
+	ErrInTransaction = errors.New("undo: transaction in progress")
+	// ErrNoTransaction is reported by Commit and Rollback when no transaction is open.
+	ErrNoTransaction = errors.New("undo: no transaction in progress")
+	// ErrUnreachable is reported by Restore when the snapshot's position is no longer in the history,
+	// because it was discarded by the depth limit, invalidated by a new command, or cleared.
+	ErrUnreachable = errors.New("undo: snapshot is no longer reachable")
+)
+
+// Command is a reversible action managed by a History.
+// Do applies the action and Undo reverts it; Do is called again to redo it.
+// If either returns an error, the History assumes the action had no effect.
+type Command interface {
+	// Do applies the action.
+	Do() error
+	// Undo reverts the action.
+	Undo() error
+}
+
+// funcCommand adapts a pair of functions to the Command interface.
+type funcCommand struct {
+	do, undo func() error
+}
+
+func (c funcCommand) Do() error   { return c.do() }
+func (c funcCommand) Undo() error { return c.undo() }
+
+// Func returns a Command that calls do to apply the action and undo to revert it.
+//
+// Parameters:
+//   - do: the function applying the action
+//   - undo: the function reverting the action
+//
+// Returns:
+//   - a Command calling do and undo
+//
+// Example:
+//
+//	old := doc.Title
+//	h.Do(undo.Func(
+//	    func() error { doc.Title = "New"; return nil },
+//	    func() error { doc.Title = old; return nil },
+//	))
+func Func(do, undo func() error) Command {
+	return funcCommand{do: do, undo: undo}
+}
+
+// group is a Command made of several commands applied in order and reverted in reverse order.
+type group []Command
+
+// Do applies the commands in order. If one fails, the ones already applied are reverted.
+func (g group) Do() error {
+	for i, cmd := range g {
+		if err := cmd.Do(); err != nil {
+			return errors.Join(err, group(g[:i]).Undo())
+		}
+	}
+	return nil
+}
+
+// Undo reverts the commands in reverse order. If one fails, the ones already reverted are reapplied.
+func (g group) Undo() error {
+	for i := len(g) - 1; i >= 0; i-- {
+		if err := g[i].Undo(); err != nil {
+			return errors.Join(err, group(g[i+1:]).Do())
+		}
+	}
+	return nil
+}
+
+// entry is a step of the history, identified by a sequence number for snapshots.
+type entry struct {
+	cmd Command // the command, or the group of a committed transaction
+	id  uint64  // unique, increasing sequence number
+}
+
+// Snapshot identifies a position in a History, such as the state of a document when it was saved.
+// It is obtained from History.Snapshot and passed to History.Restore or History.IsAt.
+type Snapshot struct {
+	id uint64 // id of the newest applied entry at the time of the snapshot, or of the discarded base
+}
+
+// History records executed commands so that they can be undone and redone.
+// Executing a new command discards the commands that could have been redone.
+// Commands executed inside a transaction are recorded as a single step.
+// A History is not safe for concurrent use.
+// A History must be created with New.
+type History struct {
+	undo   *deque.Deque[entry]      // applied steps, oldest at the front and newest at the back
+	redo   *stack.Stack[entry]      // undone steps, most recently undone on top
+	open   *stack.Stack[*[]Command] // commands of the open transactions, innermost on top
+	depth  int                      // maximum number of undo steps, 0 if unbounded
+	base   uint64                   // id of the newest step discarded by the depth limit
+	nextID uint64                   // id of the next recorded step
+}
+
+// New creates and returns a new empty History.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - depth: the maximum number of steps that can be undone; older steps are discarded.
+//     A depth <= 0 keeps every step.
+//
+// Returns:
+//   - a new empty History
+//
+// Example:
+//
+//	h := New(100)  // remember the last 100 steps
+func New(depth int) *History {
+	return &History{
+		undo:   deque.New[entry](nil),
+		redo:   stack.New[entry](nil),
+		open:   stack.New[*[]Command](nil),
+		depth:  max(depth, 0),
+		nextID: 1,
+	}
+}
+
+// Do executes cmd and records it so that it can be undone.
+// Any steps that could have been redone are discarded.
+// Inside a transaction, cmd becomes part of the innermost transaction instead of a step of its own.
+// If cmd.Do fails, nothing is recorded and the error is returned.
+// Time complexity: O(1) amortized.
+//
+// Parameters:
+//   - cmd: the command to execute
+//
+// Returns:
+//   - the error returned by cmd.Do, or nil
+//
+// Example:
+//
+//	if err := h.Do(insertText(doc, pos, "hello")); err != nil {
+//	    return err
+//	}
+func (h *History) Do(cmd Command) error {
+	if err := cmd.Do(); err != nil {
+		return err
+	}
+	h.redo.Clear()
+	if tx, ok := h.open.TryPeek(); ok {
+		*tx = append(*tx, cmd)
+		return nil
+	}
+	h.record(cmd)
+	return nil
+}
+
+// Undo reverts the most recent step and makes it available to Redo.
+// If the command fails, the step stays in the history and the error is returned.
+// Time complexity: O(1) plus the cost of the command.
+//
+// Returns:
+//   - an error wrapping errs.ErrEmpty if there is nothing to undo, ErrInTransaction if a transaction is open,
+//     or the error returned by the command
+//
+// Example:
+//
+//	for h.CanUndo() {
+//	    h.Undo()
+//	}
+func (h *History) Undo() error {
+	if !h.open.IsEmpty() {
+		return ErrInTransaction
+	}
+	e, ok := h.undo.TryPeekBack()
+	if !ok {
+		return fmt.Errorf("undo: Undo: %w", errs.ErrEmpty)
+	}
+	if err := e.cmd.Undo(); err != nil {
+		return err
+	}
+	h.redo.Push(h.undo.PopBack())
+	return nil
+}
+
+// Redo reapplies the most recently undone step.
+// If the command fails, the step stays available to Redo and the error is returned.
+// Time complexity: O(1) plus the cost of the command.
+//
+// Returns:
+//   - an error wrapping errs.ErrEmpty if there is nothing to redo, ErrInTransaction if a transaction is open,
+//     or the error returned by the command
+func (h *History) Redo() error {
+	if !h.open.IsEmpty() {
+		return ErrInTransaction
+	}
+	e, ok := h.redo.TryPeek()
+	if !ok {
+		return fmt.Errorf("undo: Redo: %w", errs.ErrEmpty)
+	}
+	if err := e.cmd.Do(); err != nil {
+		return err
+	}
+	h.undo.PushBack(h.redo.Pop())
+	h.trim()
+	return nil
+}
+
+// CanUndo reports whether there is a step to undo.
+// Time complexity: O(1).
+func (h *History) CanUndo() bool {
+	return !h.undo.IsEmpty()
+}
+
+// CanRedo reports whether there is a step to redo.
+// Time complexity: O(1).
+func (h *History) CanRedo() bool {
+	return !h.redo.IsEmpty()
+}
+
+// UndoSize returns the number of steps that can be undone.
+// Time complexity: O(1).
+func (h *History) UndoSize() int {
+	return h.undo.Size()
+}
+
+// RedoSize returns the number of steps that can be redone.
+// Time complexity: O(1).
+func (h *History) RedoSize() int {
+	return h.redo.Size()
+}
+
+// Begin opens a transaction. Commands executed until the matching Commit are recorded as one step,
+// so a single Undo reverts all of them. Transactions may be nested; an inner transaction that commits
+// becomes part of the outer one.
+// Time complexity: O(1).
+//
+// Example:
+//
+//	h.Begin()
+//	h.Do(deleteSelection(doc))
+//	h.Do(insertText(doc, pos, clipboard))
+//	h.Commit()  // one Undo restores the selection
+func (h *History) Begin() {
+	h.open.Push(new([]Command))
+}
+
+// Commit closes the innermost transaction. Its commands are recorded as a single step,
+// or added to the enclosing transaction if there is one. An empty transaction records nothing.
+// Time complexity: O(1) amortized.
+//
+// Returns:
+//   - ErrNoTransaction if no transaction is open, nil otherwise
+func (h *History) Commit() error {
+	tx, ok := h.open.TryPop()
+	if !ok {
+		return ErrNoTransaction
+	}
+	if len(*tx) == 0 {
+		return nil
+	}
+	if outer, ok := h.open.TryPeek(); ok {
+		*outer = append(*outer, group(*tx))
+		return nil
+	}
+	h.record(group(*tx))
+	return nil
+}
+
+// Rollback closes the innermost transaction and reverts its commands in reverse order.
+// Nothing is recorded. If a command fails to revert, the remaining commands are still reverted
+// and the errors are joined.
+// Time complexity: O(k) plus the cost of the commands, where k is the number of commands in the transaction.
+//
+// Returns:
+//   - ErrNoTransaction if no transaction is open, the joined errors of the commands, or nil
+func (h *History) Rollback() error {
+	tx, ok := h.open.TryPop()
+	if !ok {
+		return ErrNoTransaction
+	}
+	var errList []error
+	for i := len(*tx) - 1; i >= 0; i-- {
+		errList = append(errList, (*tx)[i].Undo())
+	}
+	return errors.Join(errList...)
+}
+
+// InTransaction reports whether a transaction is open.
+// Time complexity: O(1).
+func (h *History) InTransaction() bool {
+	return !h.open.IsEmpty()
+}
+
+// Snapshot returns the current position in the history, for example when a document is saved.
+// Commands executed inside an open transaction are not part of any position until it commits.
+// Time complexity: O(1).
+//
+// Returns:
+//   - a Snapshot of the current position
+//
+// Example:
+//
+//	saved := h.Snapshot()
+//	// ... edits ...
+//	modified := !h.IsAt(saved)
+func (h *History) Snapshot() Snapshot {
+	return Snapshot{id: h.position()}
+}
+
+// IsAt reports whether the history is at the position captured by s.
+// Time complexity: O(1).
+//
+// Parameters:
+//   - s: the snapshot to compare with
+//
+// Returns:
+//   - true if no step has been applied or undone since s was taken, or all such changes cancel out
+func (h *History) IsAt(s Snapshot) bool {
+	return h.position() == s.id
+}
+
+// Restore undoes or redoes steps until the history is back at the position captured by s.
+// If a command fails, Restore stops at the step that failed and returns the error.
+// Time complexity: O(k) plus the cost of the commands, where k is the number of steps in the history.
+//
+// Parameters:
+//   - s: the snapshot to return to
+//
+// Returns:
+//   - ErrUnreachable if the position is no longer in the history, ErrInTransaction if a transaction is open,
+//     the error returned by a command, or nil
+//
+// Example:
+//
+//	saved := h.Snapshot()
+//	h.Do(edit1)
+//	h.Do(edit2)
+//	h.Restore(saved)  // undoes edit2 and edit1
+func (h *History) Restore(s Snapshot) error {
+	if !h.open.IsEmpty() {
+		return ErrInTransaction
+	}
+	switch {
+	case h.position() == s.id:
+		return nil
+	case s.id == h.base || contains(h.undo.All(), s.id):
+		for h.position() != s.id {
+			if err := h.Undo(); err != nil {
+				return err
+			}
+		}
+	case contains(h.redo.All(), s.id):
+		for h.position() != s.id {
+			if err := h.Redo(); err != nil {
+				return err
+			}
+		}
+	default:
+		return ErrUnreachable
+	}
+	return nil
+}
+
+// Clear discards every step, so that nothing can be undone or redone.
+// Snapshots taken before Clear become unreachable, except for the current position.
+// Time complexity: O(1).
+//
+// Returns:
+//   - ErrInTransaction if a transaction is open, nil otherwise
+func (h *History) Clear() error {
+	if !h.open.IsEmpty() {
+		return ErrInTransaction
+	}
+	h.base = h.position()
+	h.undo.Clear()
+	h.redo.Clear()
+	return nil
+}
+
+// record pushes cmd as a new step and enforces the depth limit.
+func (h *History) record(cmd Command) {
+	h.undo.PushBack(entry{cmd: cmd, id: h.nextID})
+	h.nextID++
+	h.trim()
+}
+
+// trim discards the oldest step if the history is deeper than its limit.
+func (h *History) trim() {
+	if h.depth > 0 && h.undo.Size() > h.depth {
+		h.base = h.undo.PopFront().id
+	}
+}
+
+// position returns the id of the newest applied step, or the base if there is none.
+func (h *History) position() uint64 {
+	if e, ok := h.undo.TryPeekBack(); ok {
+		return e.id
+	}
+	return h.base
+}
+
+// contains reports whether a step with the given id is in steps.
+func contains(steps iter.Seq[entry], id uint64) bool {
+	for e := range steps {
+		if e.id == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,485 @@
+package undo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/thefrost13/gollections/errs"
+)
+
+// document is a list of lines edited through commands in the tests.
+type document struct {
+	lines []string
+}
+
+// appendLine returns a command that appends line to d.
+func (d *document) appendLine(line string) Command {
+	return Func(
+		func() error { d.lines = append(d.lines, line); return nil },
+		func() error { d.lines = d.lines[:len(d.lines)-1]; return nil },
+	)
+}
+
+func (d *document) String() string {
+	return strings.Join(d.lines, ",")
+}
+
+// flaky is a command whose Do or Undo fails while the corresponding flag is set.
+type flaky struct {
+	applied          int
+	failDo, failUndo bool
+}
+
+var errFlaky = errors.New("flaky command failed")
+
+func (f *flaky) Do() error {
+	if f.failDo {
+		return errFlaky
+	}
+	f.applied++
+	return nil
+}
+
+func (f *flaky) Undo() error {
+	if f.failUndo {
+		return errFlaky
+	}
+	f.applied--
+	return nil
+}
+
+func TestHistoryDoUndoRedo(t *testing.T) {
+	t.Run("Undo and Redo", func(t *testing.T) {
+		doc := &document{}
+		h := New(0)
+		for _, line := range []string{"a", "b", "c"} {
+			if err := h.Do(doc.appendLine(line)); err != nil {
+				t.Fatalf("Unexpected error %v", err)
+			}
+		}
+		if doc.String() != "a,b,c" || h.UndoSize() != 3 || h.CanRedo() {
+			t.Fatalf("Expected a,b,c with 3 undo steps, got %s with %d", doc, h.UndoSize())
+		}
+
+		h.Undo()
+		h.Undo()
+		if doc.String() != "a" || h.UndoSize() != 1 || h.RedoSize() != 2 {
+			t.Errorf("Expected a with 1 undo and 2 redo steps, got %s with %d and %d", doc, h.UndoSize(), h.RedoSize())
+		}
+
+		h.Redo()
+		if doc.String() != "a,b" || !h.CanUndo() || !h.CanRedo() {
+			t.Errorf("Expected a,b with undo and redo available, got %s", doc)
+		}
+	})
+
+	t.Run("New command invalidates redo", func(t *testing.T) {
+		doc := &document{}
+		h := New(0)
+		h.Do(doc.appendLine("a"))
+		h.Do(doc.appendLine("b"))
+		h.Undo()
+		h.Do(doc.appendLine("x"))
+		if h.CanRedo() {
+			t.Error("Expected redo to be discarded after a new command")
+		}
+		if err := h.Redo(); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected ErrEmpty, got %v", err)
+		}
+		if doc.String() != "a,x" {
+			t.Errorf("Expected a,x, got %s", doc)
+		}
+	})
+
+	t.Run("Empty history", func(t *testing.T) {
+		h := New(0)
+		if err := h.Undo(); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected ErrEmpty from Undo, got %v", err)
+		}
+		if err := h.Redo(); !errors.Is(err, errs.ErrEmpty) {
+			t.Errorf("Expected ErrEmpty from Redo, got %v", err)
+		}
+		if h.CanUndo() || h.CanRedo() {
+			t.Error("Expected nothing to undo or redo")
+		}
+	})
+
+	t.Run("Failing commands leave the history unchanged", func(t *testing.T) {
+		h := New(0)
+		cmd := &flaky{failDo: true}
+		if err := h.Do(cmd); !errors.Is(err, errFlaky) {
+			t.Errorf("Expected errFlaky, got %v", err)
+		}
+		if h.CanUndo() {
+			t.Error("Expected failed command not to be recorded")
+		}
+
+		cmd.failDo = false
+		h.Do(cmd)
+		cmd.failUndo = true
+		if err := h.Undo(); !errors.Is(err, errFlaky) {
+			t.Errorf("Expected errFlaky, got %v", err)
+		}
+		if h.UndoSize() != 1 || h.CanRedo() {
+			t.Error("Expected step to stay undoable after a failed Undo")
+		}
+
+		cmd.failUndo = false
+		h.Undo()
+		cmd.failDo = true
+		if err := h.Redo(); !errors.Is(err, errFlaky) {
+			t.Errorf("Expected errFlaky, got %v", err)
+		}
+		if h.RedoSize() != 1 || cmd.applied != 0 {
+			t.Errorf("Expected step to stay redoable, got %d redo steps and %d applications", h.RedoSize(), cmd.applied)
+		}
+	})
+}
+
+func TestHistoryTransactions(t *testing.T) {
+	t.Run("Commit records one step", func(t *testing.T) {
+		doc := &document{}
+		h := New(0)
+		h.Do(doc.appendLine("a"))
+		h.Begin()
+		h.Do(doc.appendLine("b"))
+		h.Do(doc.appendLine("c"))
+		if !h.InTransaction() {
+			t.Error("Expected a transaction to be open")
+		}
+		if err := h.Commit(); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if h.UndoSize() != 2 {
+			t.Errorf("Expected 2 steps, got %d", h.UndoSize())
+		}
+
+		h.Undo()
+		if doc.String() != "a" {
+			t.Errorf("Expected a, got %s", doc)
+		}
+		h.Redo()
+		if doc.String() != "a,b,c" {
+			t.Errorf("Expected a,b,c, got %s", doc)
+		}
+	})
+
+	t.Run("Nested transactions", func(t *testing.T) {
+		doc := &document{}
+		h := New(0)
+		h.Begin()
+		h.Do(doc.appendLine("a"))
+		h.Begin()
+		h.Do(doc.appendLine("b"))
+		h.Commit()
+		h.Begin()
+		h.Do(doc.appendLine("c"))
+		if err := h.Rollback(); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if doc.String() != "a,b" {
+			t.Errorf("Expected inner rollback to revert only c, got %s", doc)
+		}
+		h.Commit()
+		if h.InTransaction() || h.UndoSize() != 1 {
+			t.Errorf("Expected one step after the outer commit, got %d", h.UndoSize())
+		}
+		h.Undo()
+		if doc.String() != "" {
+			t.Errorf("Expected empty document, got %s", doc)
+		}
+	})
+
+	t.Run("Rollback reverts in reverse order", func(t *testing.T) {
+		doc := &document{lines: []string{"x"}}
+		h := New(0)
+		h.Begin()
+		h.Do(doc.appendLine("a"))
+		h.Do(doc.appendLine("b"))
+		h.Rollback()
+		if doc.String() != "x" || h.CanUndo() {
+			t.Errorf("Expected x with nothing recorded, got %s", doc)
+		}
+	})
+
+	t.Run("Empty transaction records nothing", func(t *testing.T) {
+		h := New(0)
+		h.Begin()
+		h.Commit()
+		if h.CanUndo() {
+			t.Error("Expected empty transaction not to be recorded")
+		}
+	})
+
+	t.Run("Transaction state errors", func(t *testing.T) {
+		h := New(0)
+		if err := h.Commit(); !errors.Is(err, ErrNoTransaction) {
+			t.Errorf("Expected ErrNoTransaction from Commit, got %v", err)
+		}
+		if err := h.Rollback(); !errors.Is(err, ErrNoTransaction) {
+			t.Errorf("Expected ErrNoTransaction from Rollback, got %v", err)
+		}
+
+		h.Begin()
+		for name, fn := range map[string]func() error{
+			"Undo":    h.Undo,
+			"Redo":    h.Redo,
+			"Clear":   h.Clear,
+			"Restore": func() error { return h.Restore(Snapshot{}) },
+		} {
+			if err := fn(); !errors.Is(err, ErrInTransaction) {
+				t.Errorf("Expected ErrInTransaction from %s, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("Failing group undo restores the group", func(t *testing.T) {
+		first, second := &flaky{}, &flaky{}
+		h := New(0)
+		h.Begin()
+		h.Do(first)
+		h.Do(second)
+		h.Commit()
+
+		first.failUndo = true
+		if err := h.Undo(); !errors.Is(err, errFlaky) {
+			t.Errorf("Expected errFlaky, got %v", err)
+		}
+		if first.applied != 1 || second.applied != 1 || h.UndoSize() != 1 {
+			t.Errorf("Expected group to stay applied, got %d and %d", first.applied, second.applied)
+		}
+
+		first.failUndo = false
+		h.Undo()
+		second.failDo = true
+		if err := h.Redo(); !errors.Is(err, errFlaky) {
+			t.Errorf("Expected errFlaky, got %v", err)
+		}
+		if first.applied != 0 || second.applied != 0 || h.RedoSize() != 1 {
+			t.Errorf("Expected group to stay undone, got %d and %d", first.applied, second.applied)
+		}
+	})
+
+	t.Run("Failing rollback joins errors", func(t *testing.T) {
+		first, second := &flaky{}, &flaky{failUndo: true}
+		h := New(0)
+		h.Begin()
+		h.Do(first)
+		h.Do(second)
+		if err := h.Rollback(); !errors.Is(err, errFlaky) {
+			t.Errorf("Expected errFlaky, got %v", err)
+		}
+		if first.applied != 0 {
+			t.Error("Expected the remaining commands to be reverted")
+		}
+		if h.InTransaction() {
+			t.Error("Expected the transaction to be closed")
+		}
+	})
+}
+
+func TestHistoryDepth(t *testing.T) {
+	doc := &document{}
+	h := New(2)
+	for _, line := range []string{"a", "b", "c", "d"} {
+		h.Do(doc.appendLine(line))
+	}
+	if h.UndoSize() != 2 {
+		t.Errorf("Expected 2 steps, got %d", h.UndoSize())
+	}
+	for h.CanUndo() {
+		h.Undo()
+	}
+	if doc.String() != "a,b" {
+		t.Errorf("Expected only the last 2 steps to be undone, got %s", doc)
+	}
+	h.Redo()
+	h.Redo()
+	if doc.String() != "a,b,c,d" || h.UndoSize() != 2 {
+		t.Errorf("Expected a,b,c,d with 2 steps, got %s with %d", doc, h.UndoSize())
+	}
+
+	t.Run("full depth keeps the newest steps", func(t *testing.T) {
+		h := New(3)
+		var snapshots []Snapshot
+		for i := 0; i < 10; i++ {
+			snapshots = append(snapshots, h.Snapshot())
+			h.Do(Func(func() error { return nil }, func() error { return nil }))
+		}
+		if h.UndoSize() != 3 {
+			t.Errorf("Expected 3 steps, got %d", h.UndoSize())
+		}
+		if err := h.Restore(snapshots[6]); !errors.Is(err, ErrUnreachable) {
+			t.Errorf("Expected discarded position to be unreachable, got %v", err)
+		}
+		if err := h.Restore(snapshots[7]); err != nil || h.CanUndo() {
+			t.Errorf("Expected to restore the oldest kept position, got %v with %d steps", err, h.UndoSize())
+		}
+	})
+
+	if unbounded := New(-1); unbounded.depth != 0 {
+		t.Errorf("Expected negative depth to be unbounded, got %d", unbounded.depth)
+	}
+}
+
+func TestHistorySnapshots(t *testing.T) {
+	t.Run("Restore backward and forward", func(t *testing.T) {
+		doc := &document{}
+		h := New(0)
+		initial := h.Snapshot()
+		h.Do(doc.appendLine("a"))
+		saved := h.Snapshot()
+		h.Do(doc.appendLine("b"))
+		h.Do(doc.appendLine("c"))
+
+		if h.IsAt(saved) {
+			t.Error("Expected history to have moved past the snapshot")
+		}
+		if err := h.Restore(saved); err != nil || doc.String() != "a" || !h.IsAt(saved) {
+			t.Errorf("Expected a at the snapshot, got %s (%v)", doc, err)
+		}
+		if err := h.Restore(initial); err != nil || doc.String() != "" {
+			t.Errorf("Expected empty document, got %s (%v)", doc, err)
+		}
+
+		h.Redo()
+		h.Redo()
+		latest := h.Snapshot()
+		h.Restore(initial)
+		if err := h.Restore(latest); err != nil || doc.String() != "a,b" {
+			t.Errorf("Expected a,b after restoring forward, got %s (%v)", doc, err)
+		}
+		if err := h.Restore(latest); err != nil {
+			t.Errorf("Expected restoring the current position to succeed, got %v", err)
+		}
+	})
+
+	t.Run("Unreachable snapshots", func(t *testing.T) {
+		doc := &document{}
+		h := New(0)
+		h.Do(doc.appendLine("a"))
+		h.Do(doc.appendLine("b"))
+		branch := h.Snapshot()
+		h.Undo()
+		h.Do(doc.appendLine("x"))
+		if err := h.Restore(branch); !errors.Is(err, ErrUnreachable) {
+			t.Errorf("Expected ErrUnreachable after redo invalidation, got %v", err)
+		}
+		if doc.String() != "a,x" {
+			t.Errorf("Expected the document to be unchanged, got %s", doc)
+		}
+	})
+
+	t.Run("Depth limit keeps the base reachable", func(t *testing.T) {
+		doc := &document{}
+		h := New(2)
+		initial := h.Snapshot()
+		h.Do(doc.appendLine("a"))
+		afterA := h.Snapshot()
+		h.Do(doc.appendLine("b"))
+		h.Do(doc.appendLine("c"))
+
+		if err := h.Restore(initial); !errors.Is(err, ErrUnreachable) {
+			t.Errorf("Expected ErrUnreachable for a discarded position, got %v", err)
+		}
+		if err := h.Restore(afterA); err != nil || doc.String() != "a" {
+			t.Errorf("Expected a, got %s (%v)", doc, err)
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		doc := &document{}
+		h := New(0)
+		initial := h.Snapshot()
+		h.Do(doc.appendLine("a"))
+		current := h.Snapshot()
+		if err := h.Clear(); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if h.CanUndo() || h.CanRedo() {
+			t.Error("Expected nothing to undo or redo after Clear")
+		}
+		if !h.IsAt(current) {
+			t.Error("Expected the current position to survive Clear")
+		}
+		if err := h.Restore(initial); !errors.Is(err, ErrUnreachable) {
+			t.Errorf("Expected ErrUnreachable, got %v", err)
+		}
+		h.Do(doc.appendLine("b"))
+		if err := h.Restore(current); err != nil || doc.String() != "a" {
+			t.Errorf("Expected a, got %s (%v)", doc, err)
+		}
+	})
+
+	t.Run("Restore stops at a failing command", func(t *testing.T) {
+		doc := &document{}
+		cmd := &flaky{}
+		h := New(0)
+		initial := h.Snapshot()
+		h.Do(cmd)
+		h.Do(doc.appendLine("a"))
+		end := h.Snapshot()
+		cmd.failUndo = true
+		if err := h.Restore(initial); !errors.Is(err, errFlaky) {
+			t.Errorf("Expected errFlaky, got %v", err)
+		}
+		if len(doc.lines) != 0 || h.UndoSize() != 1 {
+			t.Errorf("Expected to stop after undoing a, got %v with %d steps", doc.lines, h.UndoSize())
+		}
+
+		cmd.failUndo = false
+		h.Restore(initial)
+		cmd.failDo = true
+		if err := h.Restore(end); !errors.Is(err, errFlaky) {
+			t.Errorf("Expected errFlaky while redoing, got %v", err)
+		}
+		if len(doc.lines) != 0 || h.RedoSize() != 2 {
+			t.Errorf("Expected nothing to be redone, got %v with %d redo steps", doc.lines, h.RedoSize())
+		}
+	})
+}
+
+// Benchmark tests
+func BenchmarkHistoryDo(b *testing.B) {
+	h := New(0)
+	cmd := &flaky{}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		h.Do(cmd)
+	}
+}
+
+func BenchmarkHistoryDoBounded(b *testing.B) {
+	for _, depth := range []int{100, 10000} {
+		b.Run(fmt.Sprintf("depth %d", depth), func(b *testing.B) {
+			h := New(depth)
+			cmd := &flaky{}
+			for i := 0; i < depth; i++ {
+				h.Do(cmd)
+			}
+			b.ResetTimer()
+
+			// every Do runs at full depth and discards the oldest step
+			for i := 0; i < b.N; i++ {
+				h.Do(cmd)
+			}
+		})
+	}
+}
+
+func BenchmarkHistoryUndoRedo(b *testing.B) {
+	h := New(0)
+	cmd := &flaky{}
+	for i := 0; i < 100; i++ {
+		h.Do(cmd)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		h.Undo()
+		h.Redo()
+	}
+}